@@ -3,6 +3,7 @@ package main
 import (
 	"content-service/cmd/server"
 	"content-service/config"
+	"content-service/currency"
 	"content-service/generated/communication"
 	"content-service/generated/destination"
 	"content-service/generated/itineraries"
@@ -11,9 +12,16 @@ import (
 	"content-service/service"
 	"content-service/storage/postgres"
 	"content-service/storage/redis"
+	"context"
+	"database/sql"
 	"log"
 	"log/slog"
 	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	_ "time/tzdata"
 
 	"google.golang.org/grpc"
 )
@@ -28,6 +36,23 @@ func main() {
 	}
 	defer db.Close()
 
+	// content-service import-destinations <file.csv|file.jsonl>
+	if len(os.Args) == 3 && os.Args[1] == "import-destinations" {
+		if err := importDestinationsFile(commandService(db), os.Args[2]); err != nil {
+			logs.Logger.Error("Error importing destinations", slog.String("error", err.Error()))
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// content-service score-destinations
+	if len(os.Args) == 2 && os.Args[1] == "score-destinations" {
+		if err := commandService(db).RefreshPopularity(time.Now()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	cfg := config.Load()
 	listener, err := net.Listen("tcp", cfg.GRPC_PORT)
 	if err != nil {
@@ -35,6 +60,14 @@ func main() {
 		log.Fatal(err)
 	}
 
+	rateRepo := postgres.NewExchangeRateRepo(db)
+	if cfg.EXCHANGE_RATES_FILE != "" {
+		if err := loadExchangeRates(rateRepo, cfg.EXCHANGE_RATES_FILE); err != nil {
+			logs.Logger.Error("Error loading exchange rates", slog.String("error", err.Error()))
+			log.Fatal(err)
+		}
+	}
+
 	userClient, err := server.NewUserClient(cfg)
 	if err != nil {
 		logs.Logger.Error("Error in conn userclient", slog.String("error", err.Error()))
@@ -51,16 +84,34 @@ func main() {
 	itineraries.RegisterItinerariesServiceServer(s, &service.ItineraryService{
 		ItineraryRepo: postgres.NewItinerariesRepo(db),
 		Storyrepo:     postgres.NewTravelStoriesRepo(db),
+		RateRepo:      rateRepo,
 		UserClient:    userClient,
+		ShareSecret:   []byte(cfg.SHARE_LINK_SECRET),
+		AdminIds:      splitIds(cfg.ADMIN_USER_IDS),
+		CuratorIds:    splitIds(cfg.CURATOR_USER_IDS),
 		Logger:        logs.Logger,
 	})
 
-	destination.RegisterTravelDestinationServiceServer(s, &service.DestinationService{
+	destinationService := &service.DestinationService{
 		DestinationRepo: postgres.NewDestinationRepo(db),
+		RateRepo:        rateRepo,
 		UserClient:      &userClient,
 		Logger:          logs.Logger,
 		RedisClient:     redis.NewRedisClient(),
-	})
+		AdminIds:        splitIds(cfg.ADMIN_USER_IDS),
+	}
+	destination.RegisterTravelDestinationServiceServer(s, destinationService)
+
+	// POPULARITY_INTERVAL=0 turns the job off, e.g. when score-destinations
+	// runs from cron instead.
+	popularityInterval, err := time.ParseDuration(cfg.POPULARITY_INTERVAL)
+	if err != nil {
+		logs.Logger.Error("Invalid POPULARITY_INTERVAL", slog.String("error", err.Error()))
+		log.Fatal(err)
+	}
+	if popularityInterval > 0 {
+		go destinationService.RunPopularityJob(context.Background(), popularityInterval)
+	}
 
 	communication.RegisterCommunicationServiceServer(s, &service.CommunicationService{
 		CommunicationRepo: postgres.NewCommunicationRepo(db),
@@ -78,3 +129,59 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// splitIds splits a comma separated list of user ids.
+func splitIds(list string) []string {
+	return strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == ' ' })
+}
+
+// commandService is the destination service of the command line tools.
+// They change what the trending cache holds, so they get Redis to
+// invalidate it like the server does.
+func commandService(db *sql.DB) *service.DestinationService {
+	return &service.DestinationService{
+		DestinationRepo: postgres.NewDestinationRepo(db),
+		RedisClient:     redis.NewRedisClient(),
+		Logger:          logs.Logger,
+	}
+}
+
+// loadExchangeRates seeds the exchange rate table from a CSV file.
+func loadExchangeRates(repo *postgres.ExchangeRateRepo, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rates, err := currency.Parse(f)
+	if err != nil {
+		return err
+	}
+
+	return repo.UpsertRates(rates)
+}
+
+// importDestinationsFile upserts the destinations of a CSV or JSON Lines
+// file, the format is taken from the file extension.
+func importDestinationsFile(svc *service.DestinationService, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+
+	resp, err := svc.ImportDestinationsFrom(f, format)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range resp.Errors {
+		log.Printf("line %d (%s): %s", e.Line, e.Name, e.Message)
+	}
+	log.Printf("destinations imported: %d created, %d updated, %d failed", resp.Created, resp.Updated, resp.Failed)
+
+	return nil
+}
@@ -16,6 +16,14 @@ type Config struct {
 	DB_USER          string
 	DB_NAME          string
 	DB_PASSWORD      string
+
+	EXCHANGE_RATES_FILE string
+	SHARE_LINK_SECRET   string
+	POPULARITY_INTERVAL string
+
+	// Comma separated user ids; the auth service has no roles.
+	ADMIN_USER_IDS   string
+	CURATOR_USER_IDS string
 }
 
 func Load() Config {
@@ -32,6 +40,11 @@ func Load() Config {
 	config.DB_PASSWORD = cast.ToString(coalesce("DB_PASSWORD", "passwrod"))
 	config.USER_CLIENT_PORT = cast.ToString(coalesce("USER_CLIENT_PORT", 50050))
 	config.GRPC_PORT = cast.ToString(coalesce("GRPC_PORT", 50051))
+	config.EXCHANGE_RATES_FILE = cast.ToString(coalesce("EXCHANGE_RATES_FILE", ""))
+	config.SHARE_LINK_SECRET = cast.ToString(coalesce("SHARE_LINK_SECRET", ""))
+	config.POPULARITY_INTERVAL = cast.ToString(coalesce("POPULARITY_INTERVAL", "1h"))
+	config.ADMIN_USER_IDS = cast.ToString(coalesce("ADMIN_USER_IDS", ""))
+	config.CURATOR_USER_IDS = cast.ToString(coalesce("CURATOR_USER_IDS", ""))
 
 	return config
 }
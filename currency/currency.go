@@ -0,0 +1,151 @@
+// Package currency converts amounts between currencies using a table of
+// offline exchange rates quoted against a single base currency.
+package currency
+
+import (
+	"content-service/models"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Base is the currency every rate is quoted against.
+const Base = "USD"
+
+var ErrUnknownCurrency = errors.New("no exchange rate for currency")
+
+// Rates holds the latest known rate of every currency.
+type Rates struct {
+	byCode map[string]models.ExchangeRate
+}
+
+// New builds a rate table. When a currency appears more than once the most
+// recent rate wins.
+func New(rates []models.ExchangeRate) *Rates {
+	r := &Rates{byCode: map[string]models.ExchangeRate{}}
+	for _, rate := range rates {
+		if old, ok := r.byCode[rate.Currency]; ok && old.RateDate > rate.RateDate {
+			continue
+		}
+		r.byCode[rate.Currency] = rate
+	}
+
+	return r
+}
+
+// Convert converts an amount given in cents from one currency to another and
+// returns it in cents together with the date of the oldest rate involved.
+// The date is empty when no rate was needed.
+func (r *Rates) Convert(cents int64, from, to string) (int64, string, error) {
+	if from == to {
+		return cents, "", nil
+	}
+
+	fromRate, fromDate, err := r.rate(from)
+	if err != nil {
+		return 0, "", err
+	}
+	toRate, toDate, err := r.rate(to)
+	if err != nil {
+		return 0, "", err
+	}
+
+	converted := int64(math.Round(float64(cents) * toRate / fromRate))
+
+	return converted, OlderDate(fromDate, toDate), nil
+}
+
+func (r *Rates) rate(code string) (float64, string, error) {
+	if code == Base {
+		return 1, "", nil
+	}
+
+	rate, ok := r.byCode[code]
+	if !ok {
+		return 0, "", fmt.Errorf("%w %s", ErrUnknownCurrency, code)
+	}
+
+	return rate.Rate, rate.RateDate, nil
+}
+
+// OlderDate returns the earlier of two YYYY-MM-DD dates, ignoring empty ones.
+func OlderDate(a, b string) string {
+	if a == "" || (b != "" && b < a) {
+		return b
+	}
+
+	return a
+}
+
+// Parse reads rates from CSV lines of the form "currency,rate,date", e.g.
+// "EUR,0.9214,2024-05-01", where rate is the amount of currency one unit of
+// Base buys. Blank lines and lines starting with # are skipped.
+func Parse(r io.Reader) ([]models.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	var rates []models.ExchangeRate
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		rate, err := parseRate(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+func parseRate(record []string) (models.ExchangeRate, error) {
+	rate := models.ExchangeRate{
+		Currency: strings.ToUpper(strings.TrimSpace(record[0])),
+		RateDate: strings.TrimSpace(record[2]),
+	}
+
+	if err := Validate(rate.Currency); err != nil {
+		return rate, err
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+	if err != nil || value <= 0 || math.IsInf(value, 0) {
+		return rate, fmt.Errorf("invalid rate %q", record[1])
+	}
+	rate.Rate = value
+
+	if _, err := time.Parse("2006-01-02", rate.RateDate); err != nil {
+		return rate, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", rate.RateDate)
+	}
+
+	return rate, nil
+}
+
+// Validate checks that code looks like an ISO 4217 code, e.g. USD.
+func Validate(code string) error {
+	if len(code) != 3 {
+		return fmt.Errorf("invalid currency %q", code)
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return fmt.Errorf("invalid currency %q", code)
+		}
+	}
+
+	return nil
+}
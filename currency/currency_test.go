@@ -0,0 +1,51 @@
+package currency
+
+import (
+	"content-service/models"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	rates := New([]models.ExchangeRate{
+		{Currency: "EUR", Rate: 0.95, RateDate: "2024-04-01"},
+		{Currency: "EUR", Rate: 0.92, RateDate: "2024-05-01"},
+		{Currency: "JPY", Rate: 155, RateDate: "2024-04-30"},
+	})
+
+	cents, date, err := rates.Convert(9200, "EUR", "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10000), cents)
+	assert.Equal(t, "2024-05-01", date)
+
+	cents, date, err = rates.Convert(9200, "EUR", "JPY")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1550000), cents)
+	assert.Equal(t, "2024-04-30", date)
+
+	cents, date, err = rates.Convert(123, "GBP", "GBP")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123), cents)
+	assert.Empty(t, date)
+
+	_, _, err = rates.Convert(100, "EUR", "GBP")
+	assert.True(t, errors.Is(err, ErrUnknownCurrency))
+}
+
+func TestParse(t *testing.T) {
+	rates, err := Parse(strings.NewReader("# currency,rate,date\nEUR,0.92,2024-05-01\n\njpy, 155.2, 2024-05-01\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ExchangeRate{
+		{Currency: "EUR", Rate: 0.92, RateDate: "2024-05-01"},
+		{Currency: "JPY", Rate: 155.2, RateDate: "2024-05-01"},
+	}, rates)
+
+	_, err = Parse(strings.NewReader("EUR,0.92,2024-05-01\nGBP,-1,2024-05-01\n"))
+	assert.ErrorContains(t, err, "line 2")
+
+	_, err = Parse(strings.NewReader("EURO,0.92,2024-05-01\n"))
+	assert.Error(t, err)
+}
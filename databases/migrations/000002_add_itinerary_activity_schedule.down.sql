@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_itinerary_activities_destination_id;
+
+DROP INDEX IF EXISTS idx_itinerary_destinations_itinerary_id;
+
+ALTER TABLE itinerary_activities
+    DROP COLUMN IF EXISTS notes,
+    DROP COLUMN IF EXISTS location,
+    DROP COLUMN IF EXISTS time_zone,
+    DROP COLUMN IF EXISTS end_time,
+    DROP COLUMN IF EXISTS start_time,
+    DROP COLUMN IF EXISTS day;
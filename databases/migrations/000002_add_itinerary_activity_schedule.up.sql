@@ -0,0 +1,11 @@
+ALTER TABLE itinerary_activities
+    ADD COLUMN IF NOT EXISTS day DATE,
+    ADD COLUMN IF NOT EXISTS start_time TIME,
+    ADD COLUMN IF NOT EXISTS end_time TIME,
+    ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    ADD COLUMN IF NOT EXISTS location VARCHAR(200),
+    ADD COLUMN IF NOT EXISTS notes TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_destinations_itinerary_id ON itinerary_destinations (itinerary_id);
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_activities_destination_id ON itinerary_activities (destination_id, day, start_time);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_itineraries_forked_from;
+
+ALTER TABLE itineraries
+    DROP COLUMN IF EXISTS forked_from;
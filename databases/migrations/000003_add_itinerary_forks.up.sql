@@ -0,0 +1,4 @@
+ALTER TABLE itineraries
+    ADD COLUMN IF NOT EXISTS forked_from UUID REFERENCES itineraries(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_itineraries_forked_from ON itineraries (forked_from);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS itinerary_members (
+    itinerary_id UUID REFERENCES itineraries(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role VARCHAR(10) NOT NULL CHECK (role IN ('owner', 'editor', 'viewer')),
+    status VARCHAR(10) NOT NULL DEFAULT 'invited' CHECK (status IN ('invited', 'accepted')),
+    invited_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (itinerary_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_members_user_id ON itinerary_members (user_id);
+
+INSERT INTO itinerary_members (itinerary_id, user_id, role, status, invited_by, created_at, accepted_at)
+SELECT id, author_id, 'owner', 'accepted', author_id, created_at, created_at
+FROM itineraries
+ON CONFLICT DO NOTHING;
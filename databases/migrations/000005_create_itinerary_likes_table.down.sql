@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_itinerary_comments_itinerary_id;
+
+ALTER TABLE itinerary_comments
+    DROP COLUMN IF EXISTS updated_at;
+
+DROP TABLE IF EXISTS itinerary_likes;
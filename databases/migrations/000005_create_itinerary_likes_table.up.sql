@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS itinerary_likes (
+    user_id UUID NOT NULL,
+    itinerary_id UUID REFERENCES itineraries(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, itinerary_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_likes_itinerary_id ON itinerary_likes (itinerary_id);
+
+ALTER TABLE itinerary_comments
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_comments_itinerary_id ON itinerary_comments (itinerary_id, created_at);
+
+UPDATE itineraries i
+SET
+    likes_count = 0,
+    comments_count = (
+        SELECT COUNT(*) FROM itinerary_comments c WHERE c.itinerary_id = i.id
+    );
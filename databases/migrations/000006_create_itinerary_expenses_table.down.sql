@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS itinerary_expenses;
+
+ALTER TABLE itinerary_destinations
+    DROP COLUMN IF EXISTS destination_id;
@@ -0,0 +1,17 @@
+ALTER TABLE itinerary_destinations
+    ADD COLUMN IF NOT EXISTS destination_id UUID REFERENCES destinations(id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS itinerary_expenses (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    itinerary_id UUID REFERENCES itineraries(id) ON DELETE CASCADE,
+    destination_id UUID REFERENCES itinerary_destinations(id) ON DELETE SET NULL,
+    category VARCHAR(20) NOT NULL CHECK (category IN ('accommodation', 'transport', 'food', 'activities', 'shopping', 'other')),
+    amount DECIMAL(12, 2) NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL,
+    description TEXT,
+    spent_on DATE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_expenses_itinerary_id ON itinerary_expenses (itinerary_id);
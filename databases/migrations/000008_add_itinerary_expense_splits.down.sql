@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS itinerary_expense_participants;
+
+ALTER TABLE itinerary_expenses
+    DROP COLUMN IF EXISTS split_type,
+    DROP COLUMN IF EXISTS paid_by;
+
+ALTER TABLE itineraries
+    DROP COLUMN IF EXISTS currency;
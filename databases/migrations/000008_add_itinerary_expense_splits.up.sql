@@ -0,0 +1,19 @@
+ALTER TABLE itineraries
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE itinerary_expenses
+    ADD COLUMN IF NOT EXISTS paid_by UUID,
+    ADD COLUMN IF NOT EXISTS split_type VARCHAR(10) NOT NULL DEFAULT 'none' CHECK (split_type IN ('none', 'equal', 'shares', 'exact'));
+
+UPDATE itinerary_expenses SET paid_by = created_by WHERE paid_by IS NULL;
+
+ALTER TABLE itinerary_expenses
+    ALTER COLUMN paid_by SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS itinerary_expense_participants (
+    expense_id UUID REFERENCES itinerary_expenses(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    shares INTEGER CHECK (shares > 0),
+    amount DECIMAL(12, 2) CHECK (amount >= 0),
+    PRIMARY KEY (expense_id, user_id)
+);
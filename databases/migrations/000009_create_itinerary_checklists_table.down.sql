@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS itinerary_checklist_items;
+DROP TABLE IF EXISTS itinerary_checklists;
+DROP TABLE IF EXISTS checklist_template_items;
+DROP TABLE IF EXISTS checklist_templates;
@@ -0,0 +1,67 @@
+CREATE TABLE IF NOT EXISTS checklist_templates (
+    name VARCHAR(50) PRIMARY KEY,
+    description TEXT
+);
+
+CREATE TABLE IF NOT EXISTS checklist_template_items (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    template_name VARCHAR(50) REFERENCES checklist_templates(name) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    days_before_start INTEGER,
+    position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS itinerary_checklists (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    itinerary_id UUID REFERENCES itineraries(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    template_name VARCHAR(50) REFERENCES checklist_templates(name) ON DELETE SET NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS itinerary_checklist_items (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    checklist_id UUID REFERENCES itinerary_checklists(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    assignee_id UUID,
+    due_date DATE,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    done_by UUID,
+    done_at TIMESTAMP WITH TIME ZONE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_checklists_itinerary_id ON itinerary_checklists (itinerary_id);
+CREATE INDEX IF NOT EXISTS idx_itinerary_checklist_items_checklist_id ON itinerary_checklist_items (checklist_id);
+
+INSERT INTO checklist_templates (name, description) VALUES
+    ('beach', 'Sun, sea and sand'),
+    ('hiking', 'Trails, huts and mountain weather'),
+    ('business', 'Meetings, conferences and client visits')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO checklist_template_items (template_name, title, days_before_start, position) VALUES
+    ('beach', 'Check passport validity', 30, 1),
+    ('beach', 'Buy travel insurance', 14, 2),
+    ('beach', 'Swimwear', 1, 3),
+    ('beach', 'Sunscreen and after-sun', 1, 4),
+    ('beach', 'Sunglasses and hat', 1, 5),
+    ('beach', 'Beach towel', 1, 6),
+    ('beach', 'Flip-flops', 1, 7),
+    ('hiking', 'Check trail conditions and weather forecast', 3, 1),
+    ('hiking', 'Book mountain huts', 30, 2),
+    ('hiking', 'Break in hiking boots', 14, 3),
+    ('hiking', 'Download offline maps', 2, 4),
+    ('hiking', 'First aid kit', 1, 5),
+    ('hiking', 'Rain jacket and warm layers', 1, 6),
+    ('hiking', 'Water bottles and snacks', 1, 7),
+    ('hiking', 'Headlamp', 1, 8),
+    ('business', 'Confirm meeting schedule', 7, 1),
+    ('business', 'Prepare presentation', 3, 2),
+    ('business', 'Print business cards', 7, 3),
+    ('business', 'Laptop and chargers', 1, 4),
+    ('business', 'Plug adapters', 1, 5),
+    ('business', 'Formal clothes', 1, 6),
+    ('business', 'Submit travel expense pre-approval', 14, 7);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_itineraries_template_id;
+
+ALTER TABLE itineraries
+    DROP COLUMN IF EXISTS template_id;
+
+DROP TABLE IF EXISTS itinerary_template_activities;
+DROP TABLE IF EXISTS itinerary_template_stops;
+DROP TABLE IF EXISTS itinerary_templates;
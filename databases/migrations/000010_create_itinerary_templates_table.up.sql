@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS itinerary_templates (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    title VARCHAR(200) NOT NULL,
+    description TEXT,
+    days INTEGER NOT NULL CHECK (days > 0),
+    author_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at BIGINT DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS itinerary_template_stops (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    template_id UUID REFERENCES itinerary_templates(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    start_day INTEGER NOT NULL CHECK (start_day > 0),
+    end_day INTEGER NOT NULL CHECK (end_day >= start_day),
+    destination_id UUID REFERENCES destinations(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS itinerary_template_activities (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    stop_id UUID REFERENCES itinerary_template_stops(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    day INTEGER,
+    start_time TIME,
+    end_time TIME,
+    time_zone VARCHAR(64) DEFAULT 'UTC',
+    location TEXT,
+    notes TEXT,
+    position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_template_stops_template_id ON itinerary_template_stops (template_id, start_day);
+
+ALTER TABLE itineraries
+    ADD COLUMN IF NOT EXISTS template_id UUID REFERENCES itinerary_templates(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_itineraries_template_id ON itineraries (template_id);
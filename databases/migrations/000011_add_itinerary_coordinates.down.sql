@@ -0,0 +1,9 @@
+ALTER TABLE itinerary_activities
+    DROP CONSTRAINT IF EXISTS itinerary_activities_coordinates_check,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS longitude;
+
+ALTER TABLE itinerary_destinations
+    DROP CONSTRAINT IF EXISTS itinerary_destinations_coordinates_check,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS longitude;
@@ -0,0 +1,11 @@
+ALTER TABLE itinerary_destinations
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    DROP CONSTRAINT IF EXISTS itinerary_destinations_coordinates_check,
+    ADD CONSTRAINT itinerary_destinations_coordinates_check CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+ALTER TABLE itinerary_activities
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    DROP CONSTRAINT IF EXISTS itinerary_activities_coordinates_check,
+    ADD CONSTRAINT itinerary_activities_coordinates_check CHECK ((latitude IS NULL) = (longitude IS NULL));
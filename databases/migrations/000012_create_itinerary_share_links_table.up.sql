@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS itinerary_share_links (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    itinerary_id UUID REFERENCES itineraries(id) ON DELETE CASCADE,
+    created_by UUID NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    views INTEGER NOT NULL DEFAULT 0,
+    last_viewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_share_links_itinerary_id ON itinerary_share_links (itinerary_id);
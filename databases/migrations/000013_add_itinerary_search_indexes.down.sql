@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_itineraries_popular;
+DROP INDEX IF EXISTS idx_itineraries_recent;
+DROP INDEX IF EXISTS idx_itineraries_author_id;
+
+DROP INDEX IF EXISTS idx_itinerary_destinations_name_trgm;
+DROP INDEX IF EXISTS idx_itinerary_destinations_destination_id;
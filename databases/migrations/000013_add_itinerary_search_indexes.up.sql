@@ -0,0 +1,8 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_itinerary_destinations_destination_id ON itinerary_destinations (destination_id);
+CREATE INDEX IF NOT EXISTS idx_itinerary_destinations_name_trgm ON itinerary_destinations USING GIN (name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_itineraries_author_id ON itineraries (author_id) WHERE deleted_at = 0;
+CREATE INDEX IF NOT EXISTS idx_itineraries_recent ON itineraries (created_at DESC) WHERE deleted_at = 0;
+CREATE INDEX IF NOT EXISTS idx_itineraries_popular ON itineraries (likes_count DESC, comments_count DESC, created_at DESC) WHERE deleted_at = 0;
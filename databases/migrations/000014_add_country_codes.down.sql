@@ -0,0 +1,7 @@
+ALTER TABLE itinerary_destinations
+    DROP COLUMN IF EXISTS country_code;
+
+ALTER TABLE destinations
+    DROP COLUMN IF EXISTS country_code;
+
+DROP TABLE IF EXISTS countries;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_stories_author_id;
+
+ALTER TABLE stories
+    DROP COLUMN IF EXISTS destination_id,
+    DROP COLUMN IF EXISTS country_code,
+    DROP COLUMN IF EXISTS visited_on;
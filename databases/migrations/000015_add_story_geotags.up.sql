@@ -0,0 +1,6 @@
+ALTER TABLE stories
+    ADD COLUMN IF NOT EXISTS destination_id UUID REFERENCES destinations(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS country_code CHAR(2) REFERENCES countries(code),
+    ADD COLUMN IF NOT EXISTS visited_on DATE;
+
+CREATE INDEX IF NOT EXISTS idx_stories_author_id ON stories (author_id) WHERE deleted_at = 0;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_destinations_name_country;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_destinations_name_country
+    ON destinations (LOWER(name), LOWER(country))
+    WHERE deleted_at = 0;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_destinations_latitude;
+
+ALTER TABLE destinations
+    DROP CONSTRAINT IF EXISTS destinations_coordinates_check,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS longitude;
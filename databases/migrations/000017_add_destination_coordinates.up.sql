@@ -0,0 +1,8 @@
+ALTER TABLE destinations
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    ADD CONSTRAINT destinations_coordinates_check CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+CREATE INDEX IF NOT EXISTS idx_destinations_latitude
+    ON destinations (latitude)
+    WHERE deleted_at = 0 AND latitude IS NOT NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE destinations
+    DROP COLUMN IF EXISTS trending_reason,
+    DROP COLUMN IF EXISTS popularity_updated_at;
+
+DROP INDEX IF EXISTS idx_stories_destination_id;
+
+DROP TABLE IF EXISTS destination_bookmarks;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS destination_bookmarks (
+    user_id UUID NOT NULL,
+    destination_id UUID REFERENCES destinations(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, destination_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_destination_bookmarks_destination_id ON destination_bookmarks (destination_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_stories_destination_id ON stories (destination_id) WHERE deleted_at = 0;
+
+ALTER TABLE destinations
+    ADD COLUMN IF NOT EXISTS trending_reason VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS popularity_updated_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS destination_review_votes;
+
+DROP TABLE IF EXISTS destination_reviews;
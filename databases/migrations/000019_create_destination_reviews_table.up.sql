@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS destination_reviews (
+    id UUID DEFAULT GEN_RANDOM_UUID() PRIMARY KEY,
+    destination_id UUID NOT NULL REFERENCES destinations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    content TEXT NOT NULL DEFAULT '',
+    visit_month DATE,
+    helpful_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (destination_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS destination_review_votes (
+    review_id UUID REFERENCES destination_reviews(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (review_id, user_id)
+);
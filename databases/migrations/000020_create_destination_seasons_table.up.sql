@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS destination_seasons (
+    destination_id UUID REFERENCES destinations(id) ON DELETE CASCADE,
+    month SMALLINT NOT NULL CHECK (month BETWEEN 1 AND 12),
+    crowd_level VARCHAR(10) NOT NULL DEFAULT '' CHECK (crowd_level IN ('', 'low', 'medium', 'high')),
+    temperature_band VARCHAR(10) NOT NULL DEFAULT '' CHECK (temperature_band IN ('', 'cold', 'cool', 'mild', 'warm', 'hot')),
+    recommended BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (destination_id, month)
+);
+
+CREATE INDEX IF NOT EXISTS idx_destination_seasons_month ON destination_seasons (month) WHERE recommended;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS top_attraction_translations;
+
+DROP TABLE IF EXISTS destination_activity_translations;
+
+DROP TABLE IF EXISTS destination_translations;
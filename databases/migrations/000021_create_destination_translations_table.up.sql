@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS destination_translations (
+    destination_id UUID REFERENCES destinations(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (destination_id, locale)
+);
+
+CREATE TABLE IF NOT EXISTS destination_activity_translations (
+    activity_id UUID REFERENCES destination_activities(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    activity TEXT NOT NULL,
+    PRIMARY KEY (activity_id, locale)
+);
+
+CREATE TABLE IF NOT EXISTS top_attraction_translations (
+    attraction_id UUID REFERENCES top_attractions(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    attraction TEXT NOT NULL,
+    PRIMARY KEY (attraction_id, locale)
+);
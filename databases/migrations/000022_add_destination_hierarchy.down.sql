@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_destinations_parent_id;
+
+ALTER TABLE destinations
+    DROP COLUMN IF EXISTS kind,
+    DROP COLUMN IF EXISTS parent_id;
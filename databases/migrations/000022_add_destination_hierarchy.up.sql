@@ -0,0 +1,5 @@
+ALTER TABLE destinations
+    ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES destinations(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS kind VARCHAR(10) NOT NULL DEFAULT 'city' CHECK (kind IN ('country', 'region', 'city', 'poi'));
+
+CREATE INDEX IF NOT EXISTS idx_destinations_parent_id ON destinations (parent_id) WHERE deleted_at = 0;
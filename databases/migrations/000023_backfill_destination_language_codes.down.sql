@@ -0,0 +1,7 @@
+UPDATE destinations
+SET
+    language = language_before_codes
+WHERE
+    language_before_codes IS NOT NULL;
+
+ALTER TABLE destinations DROP COLUMN IF EXISTS language_before_codes;
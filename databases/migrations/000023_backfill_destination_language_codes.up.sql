@@ -0,0 +1,34 @@
+-- Keep the values as they were so the down migration can put them back.
+-- Free text no code can be derived from is left as it is for a curator to fix.
+ALTER TABLE destinations ADD COLUMN IF NOT EXISTS language_before_codes VARCHAR(50);
+
+UPDATE destinations
+SET
+    language_before_codes = language
+WHERE
+    language IS NOT NULL AND language_before_codes IS NULL;
+
+UPDATE destinations d
+SET
+    language = v.code
+FROM (
+    VALUES
+        ('english', 'en'), ('french', 'fr'), ('german', 'de'), ('spanish', 'es'), ('portuguese', 'pt'),
+        ('italian', 'it'), ('dutch', 'nl'), ('russian', 'ru'), ('uzbek', 'uz'), ('kazakh', 'kk'),
+        ('kyrgyz', 'ky'), ('tajik', 'tg'), ('turkmen', 'tk'), ('turkish', 'tr'), ('arabic', 'ar'),
+        ('persian', 'fa'), ('farsi', 'fa'), ('hebrew', 'he'), ('hindi', 'hi'), ('urdu', 'ur'),
+        ('bengali', 'bn'), ('chinese', 'zh'), ('mandarin', 'zh'), ('japanese', 'ja'), ('korean', 'ko'),
+        ('thai', 'th'), ('vietnamese', 'vi'), ('indonesian', 'id'), ('malay', 'ms'), ('greek', 'el'),
+        ('polish', 'pl'), ('czech', 'cs'), ('hungarian', 'hu'), ('romanian', 'ro'), ('bulgarian', 'bg'),
+        ('ukrainian', 'uk'), ('swedish', 'sv'), ('norwegian', 'no'), ('danish', 'da'), ('finnish', 'fi'),
+        ('icelandic', 'is'), ('croatian', 'hr'), ('serbian', 'sr'), ('georgian', 'ka'), ('armenian', 'hy'),
+        ('azerbaijani', 'az'), ('swahili', 'sw'), ('filipino', 'tl'), ('tagalog', 'tl')
+) AS v(name, code)
+WHERE
+    LOWER(TRIM(d.language)) = v.name;
+
+UPDATE destinations
+SET
+    language = LOWER(TRIM(language))
+WHERE
+    TRIM(language) ~ '^[A-Za-z]{2}$';
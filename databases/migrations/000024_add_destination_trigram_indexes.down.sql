@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_destinations_description_trgm;
+
+DROP INDEX IF EXISTS idx_destinations_country_trgm;
+
+DROP INDEX IF EXISTS idx_destinations_name_trgm;
@@ -0,0 +1,7 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_destinations_name_trgm ON destinations USING GIN (name gin_trgm_ops) WHERE deleted_at = 0;
+
+CREATE INDEX IF NOT EXISTS idx_destinations_country_trgm ON destinations USING GIN (country gin_trgm_ops) WHERE deleted_at = 0;
+
+CREATE INDEX IF NOT EXISTS idx_destinations_description_trgm ON destinations USING GIN (description gin_trgm_ops) WHERE deleted_at = 0;
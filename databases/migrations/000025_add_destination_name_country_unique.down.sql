@@ -0,0 +1,2 @@
+-- Duplicates deleted by the up migration stay deleted.
+DROP INDEX IF EXISTS idx_destinations_name_country;
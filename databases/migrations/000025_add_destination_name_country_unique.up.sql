@@ -0,0 +1,37 @@
+-- Live destinations whose name and country only differ in case are the same
+-- place; the oldest is kept, the others are deleted and their children moved.
+WITH duplicates AS (
+    SELECT
+        id,
+        FIRST_VALUE(id) OVER (PARTITION BY LOWER(name), LOWER(country) ORDER BY created_at, id) AS keep_id
+    FROM
+        destinations
+    WHERE
+        deleted_at = 0
+)
+UPDATE destinations d
+SET
+    parent_id = dup.keep_id
+FROM
+    duplicates dup
+WHERE
+    d.parent_id = dup.id AND dup.id <> dup.keep_id;
+
+WITH duplicates AS (
+    SELECT
+        id,
+        FIRST_VALUE(id) OVER (PARTITION BY LOWER(name), LOWER(country) ORDER BY created_at, id) AS keep_id
+    FROM
+        destinations
+    WHERE
+        deleted_at = 0
+)
+UPDATE destinations d
+SET
+    deleted_at = DATE_PART('epoch', CURRENT_TIMESTAMP)::INT
+FROM
+    duplicates dup
+WHERE
+    d.id = dup.id AND dup.id <> dup.keep_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_destinations_name_country ON destinations (LOWER(name), LOWER(country)) WHERE deleted_at = 0;
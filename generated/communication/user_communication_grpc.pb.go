@@ -27,6 +27,7 @@ type CommunicationServiceClient interface {
 	AddTravelTips(ctx context.Context, in *AddTravelTipsRequest, opts ...grpc.CallOption) (*AddTravelTipsResponse, error)
 	GetTravelTips(ctx context.Context, in *GetTravelTipsRequest, opts ...grpc.CallOption) (*GetTravelTipsResponse, error)
 	GetUserStatics(ctx context.Context, in *GetUserStaticsRequest, opts ...grpc.CallOption) (*GetUserStaticsResponse, error)
+	GetTravelMap(ctx context.Context, in *GetTravelMapRequest, opts ...grpc.CallOption) (*GetTravelMapResponse, error)
 }
 
 type communicationServiceClient struct {
@@ -82,6 +83,15 @@ func (c *communicationServiceClient) GetUserStatics(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *communicationServiceClient) GetTravelMap(ctx context.Context, in *GetTravelMapRequest, opts ...grpc.CallOption) (*GetTravelMapResponse, error) {
+	out := new(GetTravelMapResponse)
+	err := c.cc.Invoke(ctx, "/user_communication.CommunicationService/GetTravelMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommunicationServiceServer is the server API for CommunicationService service.
 // All implementations must embed UnimplementedCommunicationServiceServer
 // for forward compatibility
@@ -91,6 +101,7 @@ type CommunicationServiceServer interface {
 	AddTravelTips(context.Context, *AddTravelTipsRequest) (*AddTravelTipsResponse, error)
 	GetTravelTips(context.Context, *GetTravelTipsRequest) (*GetTravelTipsResponse, error)
 	GetUserStatics(context.Context, *GetUserStaticsRequest) (*GetUserStaticsResponse, error)
+	GetTravelMap(context.Context, *GetTravelMapRequest) (*GetTravelMapResponse, error)
 	mustEmbedUnimplementedCommunicationServiceServer()
 }
 
@@ -113,6 +124,9 @@ func (UnimplementedCommunicationServiceServer) GetTravelTips(context.Context, *G
 func (UnimplementedCommunicationServiceServer) GetUserStatics(context.Context, *GetUserStaticsRequest) (*GetUserStaticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserStatics not implemented")
 }
+func (UnimplementedCommunicationServiceServer) GetTravelMap(context.Context, *GetTravelMapRequest) (*GetTravelMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTravelMap not implemented")
+}
 func (UnimplementedCommunicationServiceServer) mustEmbedUnimplementedCommunicationServiceServer() {}
 
 // UnsafeCommunicationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +230,24 @@ func _CommunicationService_GetUserStatics_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommunicationService_GetTravelMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTravelMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommunicationServiceServer).GetTravelMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_communication.CommunicationService/GetTravelMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommunicationServiceServer).GetTravelMap(ctx, req.(*GetTravelMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommunicationService_ServiceDesc is the grpc.ServiceDesc for CommunicationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +275,10 @@ var CommunicationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserStatics",
 			Handler:    _CommunicationService_GetUserStatics_Handler,
 		},
+		{
+			MethodName: "GetTravelMap",
+			Handler:    _CommunicationService_GetTravelMap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_communication.proto",
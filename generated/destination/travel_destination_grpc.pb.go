@@ -25,6 +25,38 @@ type TravelDestinationServiceClient interface {
 	ListTravelDestnations(ctx context.Context, in *ListDetinationRequest, opts ...grpc.CallOption) (*ListDetinationResponse, error)
 	GetTravelDestination(ctx context.Context, in *GetDestinationRequest, opts ...grpc.CallOption) (*GetDestinationResponse, error)
 	GetTrendDestinations(ctx context.Context, in *GetTrendDestinationRequest, opts ...grpc.CallOption) (*GetTrendDestinationResponse, error)
+	UpdateExchangeRates(ctx context.Context, in *UpdateExchangeRatesRequest, opts ...grpc.CallOption) (*UpdateExchangeRatesResponse, error)
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	AddDestination(ctx context.Context, in *AddDestinationRequest, opts ...grpc.CallOption) (*AddDestionationResponse, error)
+	UpdateDestination(ctx context.Context, in *UpdateDestinationRequest, opts ...grpc.CallOption) (*UpdateDestinationResponse, error)
+	DeleteDestination(ctx context.Context, in *DeleteDestinationRequest, opts ...grpc.CallOption) (*DeleteDestinationResponse, error)
+	AddDestinationActivity(ctx context.Context, in *AddDestinationItemRequest, opts ...grpc.CallOption) (*AddDestinationItemResponse, error)
+	DeleteDestinationActivity(ctx context.Context, in *DeleteDestinationItemRequest, opts ...grpc.CallOption) (*DeleteDestinationItemResponse, error)
+	ListDestinationActivities(ctx context.Context, in *ListDestinationItemsRequest, opts ...grpc.CallOption) (*ListDestinationItemsResponse, error)
+	AddTopAttraction(ctx context.Context, in *AddDestinationItemRequest, opts ...grpc.CallOption) (*AddDestinationItemResponse, error)
+	DeleteTopAttraction(ctx context.Context, in *DeleteDestinationItemRequest, opts ...grpc.CallOption) (*DeleteDestinationItemResponse, error)
+	ListTopAttractions(ctx context.Context, in *ListDestinationItemsRequest, opts ...grpc.CallOption) (*ListDestinationItemsResponse, error)
+	ImportDestinations(ctx context.Context, opts ...grpc.CallOption) (TravelDestinationService_ImportDestinationsClient, error)
+	ExportDestinations(ctx context.Context, in *ExportDestinationsRequest, opts ...grpc.CallOption) (TravelDestinationService_ExportDestinationsClient, error)
+	ListNearbyDestinations(ctx context.Context, in *ListNearbyDestinationsRequest, opts ...grpc.CallOption) (*ListNearbyDestinationsResponse, error)
+	BookmarkDestination(ctx context.Context, in *BookmarkDestinationRequest, opts ...grpc.CallOption) (*BookmarkDestinationResponse, error)
+	RemoveDestinationBookmark(ctx context.Context, in *RemoveDestinationBookmarkRequest, opts ...grpc.CallOption) (*RemoveDestinationBookmarkResponse, error)
+	AddDestinationReview(ctx context.Context, in *AddDestinationReviewRequest, opts ...grpc.CallOption) (*AddDestinationReviewResponse, error)
+	UpdateDestinationReview(ctx context.Context, in *UpdateDestinationReviewRequest, opts ...grpc.CallOption) (*UpdateDestinationReviewResponse, error)
+	DeleteDestinationReview(ctx context.Context, in *DeleteDestinationReviewRequest, opts ...grpc.CallOption) (*DeleteDestinationReviewResponse, error)
+	VoteReviewHelpful(ctx context.Context, in *VoteReviewHelpfulRequest, opts ...grpc.CallOption) (*VoteReviewHelpfulResponse, error)
+	ListDestinationReviews(ctx context.Context, in *ListDestinationReviewsRequest, opts ...grpc.CallOption) (*ListDestinationReviewsResponse, error)
+	SetDestinationSeasons(ctx context.Context, in *SetDestinationSeasonsRequest, opts ...grpc.CallOption) (*SetDestinationSeasonsResponse, error)
+	ListDestinationSeasons(ctx context.Context, in *ListDestinationSeasonsRequest, opts ...grpc.CallOption) (*ListDestinationSeasonsResponse, error)
+	ListDestinationsForMonth(ctx context.Context, in *ListDestinationsForMonthRequest, opts ...grpc.CallOption) (*ListDestinationsForMonthResponse, error)
+	SetDestinationTranslation(ctx context.Context, in *SetDestinationTranslationRequest, opts ...grpc.CallOption) (*SetDestinationTranslationResponse, error)
+	DeleteDestinationTranslation(ctx context.Context, in *DeleteDestinationTranslationRequest, opts ...grpc.CallOption) (*DeleteDestinationTranslationResponse, error)
+	ListDestinationTranslations(ctx context.Context, in *ListDestinationTranslationsRequest, opts ...grpc.CallOption) (*ListDestinationTranslationsResponse, error)
+	SetActivityTranslation(ctx context.Context, in *SetDestinationItemTranslationRequest, opts ...grpc.CallOption) (*SetDestinationItemTranslationResponse, error)
+	DeleteActivityTranslation(ctx context.Context, in *DeleteDestinationItemTranslationRequest, opts ...grpc.CallOption) (*DeleteDestinationItemTranslationResponse, error)
+	SetAttractionTranslation(ctx context.Context, in *SetDestinationItemTranslationRequest, opts ...grpc.CallOption) (*SetDestinationItemTranslationResponse, error)
+	DeleteAttractionTranslation(ctx context.Context, in *DeleteDestinationItemTranslationRequest, opts ...grpc.CallOption) (*DeleteDestinationItemTranslationResponse, error)
+	ListChildDestinations(ctx context.Context, in *ListChildDestinationsRequest, opts ...grpc.CallOption) (*ListChildDestinationsResponse, error)
 }
 
 type travelDestinationServiceClient struct {
@@ -62,6 +94,342 @@ func (c *travelDestinationServiceClient) GetTrendDestinations(ctx context.Contex
 	return out, nil
 }
 
+func (c *travelDestinationServiceClient) UpdateExchangeRates(ctx context.Context, in *UpdateExchangeRatesRequest, opts ...grpc.CallOption) (*UpdateExchangeRatesResponse, error) {
+	out := new(UpdateExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/UpdateExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) AddDestination(ctx context.Context, in *AddDestinationRequest, opts ...grpc.CallOption) (*AddDestionationResponse, error) {
+	out := new(AddDestionationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/AddDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) UpdateDestination(ctx context.Context, in *UpdateDestinationRequest, opts ...grpc.CallOption) (*UpdateDestinationResponse, error) {
+	out := new(UpdateDestinationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/UpdateDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) DeleteDestination(ctx context.Context, in *DeleteDestinationRequest, opts ...grpc.CallOption) (*DeleteDestinationResponse, error) {
+	out := new(DeleteDestinationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/DeleteDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) AddDestinationActivity(ctx context.Context, in *AddDestinationItemRequest, opts ...grpc.CallOption) (*AddDestinationItemResponse, error) {
+	out := new(AddDestinationItemResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/AddDestinationActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) DeleteDestinationActivity(ctx context.Context, in *DeleteDestinationItemRequest, opts ...grpc.CallOption) (*DeleteDestinationItemResponse, error) {
+	out := new(DeleteDestinationItemResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/DeleteDestinationActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ListDestinationActivities(ctx context.Context, in *ListDestinationItemsRequest, opts ...grpc.CallOption) (*ListDestinationItemsResponse, error) {
+	out := new(ListDestinationItemsResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListDestinationActivities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) AddTopAttraction(ctx context.Context, in *AddDestinationItemRequest, opts ...grpc.CallOption) (*AddDestinationItemResponse, error) {
+	out := new(AddDestinationItemResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/AddTopAttraction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) DeleteTopAttraction(ctx context.Context, in *DeleteDestinationItemRequest, opts ...grpc.CallOption) (*DeleteDestinationItemResponse, error) {
+	out := new(DeleteDestinationItemResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/DeleteTopAttraction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ListTopAttractions(ctx context.Context, in *ListDestinationItemsRequest, opts ...grpc.CallOption) (*ListDestinationItemsResponse, error) {
+	out := new(ListDestinationItemsResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListTopAttractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ImportDestinations(ctx context.Context, opts ...grpc.CallOption) (TravelDestinationService_ImportDestinationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TravelDestinationService_ServiceDesc.Streams[0], "/travel_destination.TravelDestinationService/ImportDestinations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &travelDestinationServiceImportDestinationsClient{stream}
+	return x, nil
+}
+
+type TravelDestinationService_ImportDestinationsClient interface {
+	Send(*ImportDestinationsRequest) error
+	CloseAndRecv() (*ImportDestinationsResponse, error)
+	grpc.ClientStream
+}
+
+type travelDestinationServiceImportDestinationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *travelDestinationServiceImportDestinationsClient) Send(m *ImportDestinationsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *travelDestinationServiceImportDestinationsClient) CloseAndRecv() (*ImportDestinationsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportDestinationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *travelDestinationServiceClient) ExportDestinations(ctx context.Context, in *ExportDestinationsRequest, opts ...grpc.CallOption) (TravelDestinationService_ExportDestinationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TravelDestinationService_ServiceDesc.Streams[1], "/travel_destination.TravelDestinationService/ExportDestinations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &travelDestinationServiceExportDestinationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TravelDestinationService_ExportDestinationsClient interface {
+	Recv() (*ExportDestinationsResponse, error)
+	grpc.ClientStream
+}
+
+type travelDestinationServiceExportDestinationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *travelDestinationServiceExportDestinationsClient) Recv() (*ExportDestinationsResponse, error) {
+	m := new(ExportDestinationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *travelDestinationServiceClient) ListNearbyDestinations(ctx context.Context, in *ListNearbyDestinationsRequest, opts ...grpc.CallOption) (*ListNearbyDestinationsResponse, error) {
+	out := new(ListNearbyDestinationsResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListNearbyDestinations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) BookmarkDestination(ctx context.Context, in *BookmarkDestinationRequest, opts ...grpc.CallOption) (*BookmarkDestinationResponse, error) {
+	out := new(BookmarkDestinationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/BookmarkDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) RemoveDestinationBookmark(ctx context.Context, in *RemoveDestinationBookmarkRequest, opts ...grpc.CallOption) (*RemoveDestinationBookmarkResponse, error) {
+	out := new(RemoveDestinationBookmarkResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/RemoveDestinationBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) AddDestinationReview(ctx context.Context, in *AddDestinationReviewRequest, opts ...grpc.CallOption) (*AddDestinationReviewResponse, error) {
+	out := new(AddDestinationReviewResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/AddDestinationReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) UpdateDestinationReview(ctx context.Context, in *UpdateDestinationReviewRequest, opts ...grpc.CallOption) (*UpdateDestinationReviewResponse, error) {
+	out := new(UpdateDestinationReviewResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/UpdateDestinationReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) DeleteDestinationReview(ctx context.Context, in *DeleteDestinationReviewRequest, opts ...grpc.CallOption) (*DeleteDestinationReviewResponse, error) {
+	out := new(DeleteDestinationReviewResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/DeleteDestinationReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) VoteReviewHelpful(ctx context.Context, in *VoteReviewHelpfulRequest, opts ...grpc.CallOption) (*VoteReviewHelpfulResponse, error) {
+	out := new(VoteReviewHelpfulResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/VoteReviewHelpful", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ListDestinationReviews(ctx context.Context, in *ListDestinationReviewsRequest, opts ...grpc.CallOption) (*ListDestinationReviewsResponse, error) {
+	out := new(ListDestinationReviewsResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListDestinationReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) SetDestinationSeasons(ctx context.Context, in *SetDestinationSeasonsRequest, opts ...grpc.CallOption) (*SetDestinationSeasonsResponse, error) {
+	out := new(SetDestinationSeasonsResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/SetDestinationSeasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ListDestinationSeasons(ctx context.Context, in *ListDestinationSeasonsRequest, opts ...grpc.CallOption) (*ListDestinationSeasonsResponse, error) {
+	out := new(ListDestinationSeasonsResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListDestinationSeasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ListDestinationsForMonth(ctx context.Context, in *ListDestinationsForMonthRequest, opts ...grpc.CallOption) (*ListDestinationsForMonthResponse, error) {
+	out := new(ListDestinationsForMonthResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListDestinationsForMonth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) SetDestinationTranslation(ctx context.Context, in *SetDestinationTranslationRequest, opts ...grpc.CallOption) (*SetDestinationTranslationResponse, error) {
+	out := new(SetDestinationTranslationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/SetDestinationTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) DeleteDestinationTranslation(ctx context.Context, in *DeleteDestinationTranslationRequest, opts ...grpc.CallOption) (*DeleteDestinationTranslationResponse, error) {
+	out := new(DeleteDestinationTranslationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/DeleteDestinationTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ListDestinationTranslations(ctx context.Context, in *ListDestinationTranslationsRequest, opts ...grpc.CallOption) (*ListDestinationTranslationsResponse, error) {
+	out := new(ListDestinationTranslationsResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListDestinationTranslations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) SetActivityTranslation(ctx context.Context, in *SetDestinationItemTranslationRequest, opts ...grpc.CallOption) (*SetDestinationItemTranslationResponse, error) {
+	out := new(SetDestinationItemTranslationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/SetActivityTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) DeleteActivityTranslation(ctx context.Context, in *DeleteDestinationItemTranslationRequest, opts ...grpc.CallOption) (*DeleteDestinationItemTranslationResponse, error) {
+	out := new(DeleteDestinationItemTranslationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/DeleteActivityTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) SetAttractionTranslation(ctx context.Context, in *SetDestinationItemTranslationRequest, opts ...grpc.CallOption) (*SetDestinationItemTranslationResponse, error) {
+	out := new(SetDestinationItemTranslationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/SetAttractionTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) DeleteAttractionTranslation(ctx context.Context, in *DeleteDestinationItemTranslationRequest, opts ...grpc.CallOption) (*DeleteDestinationItemTranslationResponse, error) {
+	out := new(DeleteDestinationItemTranslationResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/DeleteAttractionTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *travelDestinationServiceClient) ListChildDestinations(ctx context.Context, in *ListChildDestinationsRequest, opts ...grpc.CallOption) (*ListChildDestinationsResponse, error) {
+	out := new(ListChildDestinationsResponse)
+	err := c.cc.Invoke(ctx, "/travel_destination.TravelDestinationService/ListChildDestinations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TravelDestinationServiceServer is the server API for TravelDestinationService service.
 // All implementations must embed UnimplementedTravelDestinationServiceServer
 // for forward compatibility
@@ -69,6 +437,38 @@ type TravelDestinationServiceServer interface {
 	ListTravelDestnations(context.Context, *ListDetinationRequest) (*ListDetinationResponse, error)
 	GetTravelDestination(context.Context, *GetDestinationRequest) (*GetDestinationResponse, error)
 	GetTrendDestinations(context.Context, *GetTrendDestinationRequest) (*GetTrendDestinationResponse, error)
+	UpdateExchangeRates(context.Context, *UpdateExchangeRatesRequest) (*UpdateExchangeRatesResponse, error)
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	AddDestination(context.Context, *AddDestinationRequest) (*AddDestionationResponse, error)
+	UpdateDestination(context.Context, *UpdateDestinationRequest) (*UpdateDestinationResponse, error)
+	DeleteDestination(context.Context, *DeleteDestinationRequest) (*DeleteDestinationResponse, error)
+	AddDestinationActivity(context.Context, *AddDestinationItemRequest) (*AddDestinationItemResponse, error)
+	DeleteDestinationActivity(context.Context, *DeleteDestinationItemRequest) (*DeleteDestinationItemResponse, error)
+	ListDestinationActivities(context.Context, *ListDestinationItemsRequest) (*ListDestinationItemsResponse, error)
+	AddTopAttraction(context.Context, *AddDestinationItemRequest) (*AddDestinationItemResponse, error)
+	DeleteTopAttraction(context.Context, *DeleteDestinationItemRequest) (*DeleteDestinationItemResponse, error)
+	ListTopAttractions(context.Context, *ListDestinationItemsRequest) (*ListDestinationItemsResponse, error)
+	ImportDestinations(TravelDestinationService_ImportDestinationsServer) error
+	ExportDestinations(*ExportDestinationsRequest, TravelDestinationService_ExportDestinationsServer) error
+	ListNearbyDestinations(context.Context, *ListNearbyDestinationsRequest) (*ListNearbyDestinationsResponse, error)
+	BookmarkDestination(context.Context, *BookmarkDestinationRequest) (*BookmarkDestinationResponse, error)
+	RemoveDestinationBookmark(context.Context, *RemoveDestinationBookmarkRequest) (*RemoveDestinationBookmarkResponse, error)
+	AddDestinationReview(context.Context, *AddDestinationReviewRequest) (*AddDestinationReviewResponse, error)
+	UpdateDestinationReview(context.Context, *UpdateDestinationReviewRequest) (*UpdateDestinationReviewResponse, error)
+	DeleteDestinationReview(context.Context, *DeleteDestinationReviewRequest) (*DeleteDestinationReviewResponse, error)
+	VoteReviewHelpful(context.Context, *VoteReviewHelpfulRequest) (*VoteReviewHelpfulResponse, error)
+	ListDestinationReviews(context.Context, *ListDestinationReviewsRequest) (*ListDestinationReviewsResponse, error)
+	SetDestinationSeasons(context.Context, *SetDestinationSeasonsRequest) (*SetDestinationSeasonsResponse, error)
+	ListDestinationSeasons(context.Context, *ListDestinationSeasonsRequest) (*ListDestinationSeasonsResponse, error)
+	ListDestinationsForMonth(context.Context, *ListDestinationsForMonthRequest) (*ListDestinationsForMonthResponse, error)
+	SetDestinationTranslation(context.Context, *SetDestinationTranslationRequest) (*SetDestinationTranslationResponse, error)
+	DeleteDestinationTranslation(context.Context, *DeleteDestinationTranslationRequest) (*DeleteDestinationTranslationResponse, error)
+	ListDestinationTranslations(context.Context, *ListDestinationTranslationsRequest) (*ListDestinationTranslationsResponse, error)
+	SetActivityTranslation(context.Context, *SetDestinationItemTranslationRequest) (*SetDestinationItemTranslationResponse, error)
+	DeleteActivityTranslation(context.Context, *DeleteDestinationItemTranslationRequest) (*DeleteDestinationItemTranslationResponse, error)
+	SetAttractionTranslation(context.Context, *SetDestinationItemTranslationRequest) (*SetDestinationItemTranslationResponse, error)
+	DeleteAttractionTranslation(context.Context, *DeleteDestinationItemTranslationRequest) (*DeleteDestinationItemTranslationResponse, error)
+	ListChildDestinations(context.Context, *ListChildDestinationsRequest) (*ListChildDestinationsResponse, error)
 	mustEmbedUnimplementedTravelDestinationServiceServer()
 }
 
@@ -85,6 +485,102 @@ func (UnimplementedTravelDestinationServiceServer) GetTravelDestination(context.
 func (UnimplementedTravelDestinationServiceServer) GetTrendDestinations(context.Context, *GetTrendDestinationRequest) (*GetTrendDestinationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTrendDestinations not implemented")
 }
+func (UnimplementedTravelDestinationServiceServer) UpdateExchangeRates(context.Context, *UpdateExchangeRatesRequest) (*UpdateExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExchangeRates not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) AddDestination(context.Context, *AddDestinationRequest) (*AddDestionationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDestination not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) UpdateDestination(context.Context, *UpdateDestinationRequest) (*UpdateDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDestination not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) DeleteDestination(context.Context, *DeleteDestinationRequest) (*DeleteDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDestination not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) AddDestinationActivity(context.Context, *AddDestinationItemRequest) (*AddDestinationItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDestinationActivity not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) DeleteDestinationActivity(context.Context, *DeleteDestinationItemRequest) (*DeleteDestinationItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDestinationActivity not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListDestinationActivities(context.Context, *ListDestinationItemsRequest) (*ListDestinationItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDestinationActivities not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) AddTopAttraction(context.Context, *AddDestinationItemRequest) (*AddDestinationItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTopAttraction not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) DeleteTopAttraction(context.Context, *DeleteDestinationItemRequest) (*DeleteDestinationItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTopAttraction not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListTopAttractions(context.Context, *ListDestinationItemsRequest) (*ListDestinationItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopAttractions not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ImportDestinations(TravelDestinationService_ImportDestinationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportDestinations not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ExportDestinations(*ExportDestinationsRequest, TravelDestinationService_ExportDestinationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDestinations not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListNearbyDestinations(context.Context, *ListNearbyDestinationsRequest) (*ListNearbyDestinationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNearbyDestinations not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) BookmarkDestination(context.Context, *BookmarkDestinationRequest) (*BookmarkDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookmarkDestination not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) RemoveDestinationBookmark(context.Context, *RemoveDestinationBookmarkRequest) (*RemoveDestinationBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDestinationBookmark not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) AddDestinationReview(context.Context, *AddDestinationReviewRequest) (*AddDestinationReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDestinationReview not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) UpdateDestinationReview(context.Context, *UpdateDestinationReviewRequest) (*UpdateDestinationReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDestinationReview not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) DeleteDestinationReview(context.Context, *DeleteDestinationReviewRequest) (*DeleteDestinationReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDestinationReview not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) VoteReviewHelpful(context.Context, *VoteReviewHelpfulRequest) (*VoteReviewHelpfulResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteReviewHelpful not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListDestinationReviews(context.Context, *ListDestinationReviewsRequest) (*ListDestinationReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDestinationReviews not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) SetDestinationSeasons(context.Context, *SetDestinationSeasonsRequest) (*SetDestinationSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDestinationSeasons not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListDestinationSeasons(context.Context, *ListDestinationSeasonsRequest) (*ListDestinationSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDestinationSeasons not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListDestinationsForMonth(context.Context, *ListDestinationsForMonthRequest) (*ListDestinationsForMonthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDestinationsForMonth not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) SetDestinationTranslation(context.Context, *SetDestinationTranslationRequest) (*SetDestinationTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDestinationTranslation not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) DeleteDestinationTranslation(context.Context, *DeleteDestinationTranslationRequest) (*DeleteDestinationTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDestinationTranslation not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListDestinationTranslations(context.Context, *ListDestinationTranslationsRequest) (*ListDestinationTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDestinationTranslations not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) SetActivityTranslation(context.Context, *SetDestinationItemTranslationRequest) (*SetDestinationItemTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetActivityTranslation not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) DeleteActivityTranslation(context.Context, *DeleteDestinationItemTranslationRequest) (*DeleteDestinationItemTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteActivityTranslation not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) SetAttractionTranslation(context.Context, *SetDestinationItemTranslationRequest) (*SetDestinationItemTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttractionTranslation not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) DeleteAttractionTranslation(context.Context, *DeleteDestinationItemTranslationRequest) (*DeleteDestinationItemTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttractionTranslation not implemented")
+}
+func (UnimplementedTravelDestinationServiceServer) ListChildDestinations(context.Context, *ListChildDestinationsRequest) (*ListChildDestinationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChildDestinations not implemented")
+}
 func (UnimplementedTravelDestinationServiceServer) mustEmbedUnimplementedTravelDestinationServiceServer() {
 }
 
@@ -153,16 +649,603 @@ func _TravelDestinationService_GetTrendDestinations_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
-// TravelDestinationService_ServiceDesc is the grpc.ServiceDesc for TravelDestinationService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TravelDestinationService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "travel_destination.TravelDestinationService",
-	HandlerType: (*TravelDestinationServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListTravelDestnations",
-			Handler:    _TravelDestinationService_ListTravelDestnations_Handler,
+func _TravelDestinationService_UpdateExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).UpdateExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/UpdateExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).UpdateExchangeRates(ctx, req.(*UpdateExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_AddDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).AddDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/AddDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).AddDestination(ctx, req.(*AddDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_UpdateDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).UpdateDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/UpdateDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).UpdateDestination(ctx, req.(*UpdateDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_DeleteDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).DeleteDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/DeleteDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).DeleteDestination(ctx, req.(*DeleteDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_AddDestinationActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDestinationItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).AddDestinationActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/AddDestinationActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).AddDestinationActivity(ctx, req.(*AddDestinationItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_DeleteDestinationActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDestinationItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).DeleteDestinationActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/DeleteDestinationActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).DeleteDestinationActivity(ctx, req.(*DeleteDestinationItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ListDestinationActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDestinationItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListDestinationActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListDestinationActivities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListDestinationActivities(ctx, req.(*ListDestinationItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_AddTopAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDestinationItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).AddTopAttraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/AddTopAttraction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).AddTopAttraction(ctx, req.(*AddDestinationItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_DeleteTopAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDestinationItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).DeleteTopAttraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/DeleteTopAttraction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).DeleteTopAttraction(ctx, req.(*DeleteDestinationItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ListTopAttractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDestinationItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListTopAttractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListTopAttractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListTopAttractions(ctx, req.(*ListDestinationItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ImportDestinations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TravelDestinationServiceServer).ImportDestinations(&travelDestinationServiceImportDestinationsServer{stream})
+}
+
+type TravelDestinationService_ImportDestinationsServer interface {
+	SendAndClose(*ImportDestinationsResponse) error
+	Recv() (*ImportDestinationsRequest, error)
+	grpc.ServerStream
+}
+
+type travelDestinationServiceImportDestinationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *travelDestinationServiceImportDestinationsServer) SendAndClose(m *ImportDestinationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *travelDestinationServiceImportDestinationsServer) Recv() (*ImportDestinationsRequest, error) {
+	m := new(ImportDestinationsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TravelDestinationService_ExportDestinations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDestinationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TravelDestinationServiceServer).ExportDestinations(m, &travelDestinationServiceExportDestinationsServer{stream})
+}
+
+type TravelDestinationService_ExportDestinationsServer interface {
+	Send(*ExportDestinationsResponse) error
+	grpc.ServerStream
+}
+
+type travelDestinationServiceExportDestinationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *travelDestinationServiceExportDestinationsServer) Send(m *ExportDestinationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TravelDestinationService_ListNearbyDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNearbyDestinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListNearbyDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListNearbyDestinations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListNearbyDestinations(ctx, req.(*ListNearbyDestinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_BookmarkDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).BookmarkDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/BookmarkDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).BookmarkDestination(ctx, req.(*BookmarkDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_RemoveDestinationBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDestinationBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).RemoveDestinationBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/RemoveDestinationBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).RemoveDestinationBookmark(ctx, req.(*RemoveDestinationBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_AddDestinationReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDestinationReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).AddDestinationReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/AddDestinationReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).AddDestinationReview(ctx, req.(*AddDestinationReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_UpdateDestinationReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDestinationReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).UpdateDestinationReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/UpdateDestinationReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).UpdateDestinationReview(ctx, req.(*UpdateDestinationReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_DeleteDestinationReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDestinationReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).DeleteDestinationReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/DeleteDestinationReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).DeleteDestinationReview(ctx, req.(*DeleteDestinationReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_VoteReviewHelpful_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteReviewHelpfulRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).VoteReviewHelpful(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/VoteReviewHelpful",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).VoteReviewHelpful(ctx, req.(*VoteReviewHelpfulRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ListDestinationReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDestinationReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListDestinationReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListDestinationReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListDestinationReviews(ctx, req.(*ListDestinationReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_SetDestinationSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDestinationSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).SetDestinationSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/SetDestinationSeasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).SetDestinationSeasons(ctx, req.(*SetDestinationSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ListDestinationSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDestinationSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListDestinationSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListDestinationSeasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListDestinationSeasons(ctx, req.(*ListDestinationSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ListDestinationsForMonth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDestinationsForMonthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListDestinationsForMonth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListDestinationsForMonth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListDestinationsForMonth(ctx, req.(*ListDestinationsForMonthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_SetDestinationTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDestinationTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).SetDestinationTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/SetDestinationTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).SetDestinationTranslation(ctx, req.(*SetDestinationTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_DeleteDestinationTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDestinationTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).DeleteDestinationTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/DeleteDestinationTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).DeleteDestinationTranslation(ctx, req.(*DeleteDestinationTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ListDestinationTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDestinationTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListDestinationTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListDestinationTranslations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListDestinationTranslations(ctx, req.(*ListDestinationTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_SetActivityTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDestinationItemTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).SetActivityTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/SetActivityTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).SetActivityTranslation(ctx, req.(*SetDestinationItemTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_DeleteActivityTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDestinationItemTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).DeleteActivityTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/DeleteActivityTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).DeleteActivityTranslation(ctx, req.(*DeleteDestinationItemTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_SetAttractionTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDestinationItemTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).SetAttractionTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/SetAttractionTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).SetAttractionTranslation(ctx, req.(*SetDestinationItemTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_DeleteAttractionTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDestinationItemTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).DeleteAttractionTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/DeleteAttractionTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).DeleteAttractionTranslation(ctx, req.(*DeleteDestinationItemTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TravelDestinationService_ListChildDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildDestinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TravelDestinationServiceServer).ListChildDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/travel_destination.TravelDestinationService/ListChildDestinations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TravelDestinationServiceServer).ListChildDestinations(ctx, req.(*ListChildDestinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TravelDestinationService_ServiceDesc is the grpc.ServiceDesc for TravelDestinationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TravelDestinationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "travel_destination.TravelDestinationService",
+	HandlerType: (*TravelDestinationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTravelDestnations",
+			Handler:    _TravelDestinationService_ListTravelDestnations_Handler,
 		},
 		{
 			MethodName: "GetTravelDestination",
@@ -172,7 +1255,138 @@ var TravelDestinationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTrendDestinations",
 			Handler:    _TravelDestinationService_GetTrendDestinations_Handler,
 		},
+		{
+			MethodName: "UpdateExchangeRates",
+			Handler:    _TravelDestinationService_UpdateExchangeRates_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _TravelDestinationService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "AddDestination",
+			Handler:    _TravelDestinationService_AddDestination_Handler,
+		},
+		{
+			MethodName: "UpdateDestination",
+			Handler:    _TravelDestinationService_UpdateDestination_Handler,
+		},
+		{
+			MethodName: "DeleteDestination",
+			Handler:    _TravelDestinationService_DeleteDestination_Handler,
+		},
+		{
+			MethodName: "AddDestinationActivity",
+			Handler:    _TravelDestinationService_AddDestinationActivity_Handler,
+		},
+		{
+			MethodName: "DeleteDestinationActivity",
+			Handler:    _TravelDestinationService_DeleteDestinationActivity_Handler,
+		},
+		{
+			MethodName: "ListDestinationActivities",
+			Handler:    _TravelDestinationService_ListDestinationActivities_Handler,
+		},
+		{
+			MethodName: "AddTopAttraction",
+			Handler:    _TravelDestinationService_AddTopAttraction_Handler,
+		},
+		{
+			MethodName: "DeleteTopAttraction",
+			Handler:    _TravelDestinationService_DeleteTopAttraction_Handler,
+		},
+		{
+			MethodName: "ListTopAttractions",
+			Handler:    _TravelDestinationService_ListTopAttractions_Handler,
+		},
+		{
+			MethodName: "ListNearbyDestinations",
+			Handler:    _TravelDestinationService_ListNearbyDestinations_Handler,
+		},
+		{
+			MethodName: "BookmarkDestination",
+			Handler:    _TravelDestinationService_BookmarkDestination_Handler,
+		},
+		{
+			MethodName: "RemoveDestinationBookmark",
+			Handler:    _TravelDestinationService_RemoveDestinationBookmark_Handler,
+		},
+		{
+			MethodName: "AddDestinationReview",
+			Handler:    _TravelDestinationService_AddDestinationReview_Handler,
+		},
+		{
+			MethodName: "UpdateDestinationReview",
+			Handler:    _TravelDestinationService_UpdateDestinationReview_Handler,
+		},
+		{
+			MethodName: "DeleteDestinationReview",
+			Handler:    _TravelDestinationService_DeleteDestinationReview_Handler,
+		},
+		{
+			MethodName: "VoteReviewHelpful",
+			Handler:    _TravelDestinationService_VoteReviewHelpful_Handler,
+		},
+		{
+			MethodName: "ListDestinationReviews",
+			Handler:    _TravelDestinationService_ListDestinationReviews_Handler,
+		},
+		{
+			MethodName: "SetDestinationSeasons",
+			Handler:    _TravelDestinationService_SetDestinationSeasons_Handler,
+		},
+		{
+			MethodName: "ListDestinationSeasons",
+			Handler:    _TravelDestinationService_ListDestinationSeasons_Handler,
+		},
+		{
+			MethodName: "ListDestinationsForMonth",
+			Handler:    _TravelDestinationService_ListDestinationsForMonth_Handler,
+		},
+		{
+			MethodName: "SetDestinationTranslation",
+			Handler:    _TravelDestinationService_SetDestinationTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteDestinationTranslation",
+			Handler:    _TravelDestinationService_DeleteDestinationTranslation_Handler,
+		},
+		{
+			MethodName: "ListDestinationTranslations",
+			Handler:    _TravelDestinationService_ListDestinationTranslations_Handler,
+		},
+		{
+			MethodName: "SetActivityTranslation",
+			Handler:    _TravelDestinationService_SetActivityTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteActivityTranslation",
+			Handler:    _TravelDestinationService_DeleteActivityTranslation_Handler,
+		},
+		{
+			MethodName: "SetAttractionTranslation",
+			Handler:    _TravelDestinationService_SetAttractionTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteAttractionTranslation",
+			Handler:    _TravelDestinationService_DeleteAttractionTranslation_Handler,
+		},
+		{
+			MethodName: "ListChildDestinations",
+			Handler:    _TravelDestinationService_ListChildDestinations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportDestinations",
+			Handler:       _TravelDestinationService_ImportDestinations_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportDestinations",
+			Handler:       _TravelDestinationService_ExportDestinations_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "travel_destination.proto",
 }
@@ -28,6 +28,51 @@ type ItinerariesServiceClient interface {
 	ListItineraries(ctx context.Context, in *ListItinerariesRequest, opts ...grpc.CallOption) (*ListItinerariesResponse, error)
 	GetItinerary(ctx context.Context, in *GetItineraryRequest, opts ...grpc.CallOption) (*GetItineraryResponse, error)
 	LeaveComment(ctx context.Context, in *LeaveCommentRequest, opts ...grpc.CallOption) (*LeaveCommentResponse, error)
+	AddItineraryActivity(ctx context.Context, in *AddItineraryActivityRequest, opts ...grpc.CallOption) (*AddItineraryActivityResponse, error)
+	DeleteItineraryActivity(ctx context.Context, in *DeleteItineraryActivityRequest, opts ...grpc.CallOption) (*DeleteItineraryActivityResponse, error)
+	GetItineraryAgenda(ctx context.Context, in *GetItineraryAgendaRequest, opts ...grpc.CallOption) (*GetItineraryAgendaResponse, error)
+	ExportItineraryICS(ctx context.Context, in *ExportItineraryICSRequest, opts ...grpc.CallOption) (*ExportItineraryICSResponse, error)
+	ImportItineraryICS(ctx context.Context, in *ImportItineraryICSRequest, opts ...grpc.CallOption) (*ImportItineraryICSResponse, error)
+	ForkItinerary(ctx context.Context, in *ForkItineraryRequest, opts ...grpc.CallOption) (*ForkItineraryResponse, error)
+	InviteItineraryMember(ctx context.Context, in *InviteItineraryMemberRequest, opts ...grpc.CallOption) (*InviteItineraryMemberResponse, error)
+	AcceptItineraryInvitation(ctx context.Context, in *AcceptItineraryInvitationRequest, opts ...grpc.CallOption) (*AcceptItineraryInvitationResponse, error)
+	RemoveItineraryMember(ctx context.Context, in *RemoveItineraryMemberRequest, opts ...grpc.CallOption) (*RemoveItineraryMemberResponse, error)
+	ListItineraryMembers(ctx context.Context, in *ListItineraryMembersRequest, opts ...grpc.CallOption) (*ListItineraryMembersResponse, error)
+	ListMyItineraries(ctx context.Context, in *ListMyItinerariesRequest, opts ...grpc.CallOption) (*ListMyItinerariesResponse, error)
+	LikeItinerary(ctx context.Context, in *LikeItineraryRequest, opts ...grpc.CallOption) (*LikeItineraryResponse, error)
+	UnlikeItinerary(ctx context.Context, in *UnlikeItineraryRequest, opts ...grpc.CallOption) (*UnlikeItineraryResponse, error)
+	ListItineraryComments(ctx context.Context, in *ListItineraryCommentsRequest, opts ...grpc.CallOption) (*ListItineraryCommentsResponse, error)
+	UpdateItineraryComment(ctx context.Context, in *UpdateItineraryCommentRequest, opts ...grpc.CallOption) (*UpdateItineraryCommentResponse, error)
+	DeleteItineraryComment(ctx context.Context, in *DeleteItineraryCommentRequest, opts ...grpc.CallOption) (*DeleteItineraryCommentResponse, error)
+	LinkCatalogDestination(ctx context.Context, in *LinkCatalogDestinationRequest, opts ...grpc.CallOption) (*LinkCatalogDestinationResponse, error)
+	AddItineraryExpense(ctx context.Context, in *AddItineraryExpenseRequest, opts ...grpc.CallOption) (*AddItineraryExpenseResponse, error)
+	DeleteItineraryExpense(ctx context.Context, in *DeleteItineraryExpenseRequest, opts ...grpc.CallOption) (*DeleteItineraryExpenseResponse, error)
+	ListItineraryExpenses(ctx context.Context, in *ListItineraryExpensesRequest, opts ...grpc.CallOption) (*ListItineraryExpensesResponse, error)
+	GetItineraryBudget(ctx context.Context, in *GetItineraryBudgetRequest, opts ...grpc.CallOption) (*GetItineraryBudgetResponse, error)
+	GetSettlement(ctx context.Context, in *GetSettlementRequest, opts ...grpc.CallOption) (*GetSettlementResponse, error)
+	CreateChecklist(ctx context.Context, in *CreateChecklistRequest, opts ...grpc.CallOption) (*CreateChecklistResponse, error)
+	ListChecklistTemplates(ctx context.Context, in *ListChecklistTemplatesRequest, opts ...grpc.CallOption) (*ListChecklistTemplatesResponse, error)
+	ApplyChecklistTemplate(ctx context.Context, in *ApplyChecklistTemplateRequest, opts ...grpc.CallOption) (*ApplyChecklistTemplateResponse, error)
+	ListChecklists(ctx context.Context, in *ListChecklistsRequest, opts ...grpc.CallOption) (*ListChecklistsResponse, error)
+	DeleteChecklist(ctx context.Context, in *DeleteChecklistRequest, opts ...grpc.CallOption) (*DeleteChecklistResponse, error)
+	AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*AddChecklistItemResponse, error)
+	UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest, opts ...grpc.CallOption) (*UpdateChecklistItemResponse, error)
+	SetChecklistItemDone(ctx context.Context, in *SetChecklistItemDoneRequest, opts ...grpc.CallOption) (*SetChecklistItemDoneResponse, error)
+	DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*DeleteChecklistItemResponse, error)
+	GetChecklistReminders(ctx context.Context, in *GetChecklistRemindersRequest, opts ...grpc.CallOption) (*GetChecklistRemindersResponse, error)
+	CreateItineraryTemplate(ctx context.Context, in *CreateItineraryTemplateRequest, opts ...grpc.CallOption) (*CreateItineraryTemplateResponse, error)
+	ListItineraryTemplates(ctx context.Context, in *ListItineraryTemplatesRequest, opts ...grpc.CallOption) (*ListItineraryTemplatesResponse, error)
+	GetItineraryTemplate(ctx context.Context, in *GetItineraryTemplateRequest, opts ...grpc.CallOption) (*GetItineraryTemplateResponse, error)
+	DeleteItineraryTemplate(ctx context.Context, in *DeleteItineraryTemplateRequest, opts ...grpc.CallOption) (*DeleteItineraryTemplateResponse, error)
+	InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateResponse, error)
+	SetDestinationCoordinates(ctx context.Context, in *SetDestinationCoordinatesRequest, opts ...grpc.CallOption) (*SetDestinationCoordinatesResponse, error)
+	ExportItineraryRoute(ctx context.Context, in *ExportItineraryRouteRequest, opts ...grpc.CallOption) (*ExportItineraryRouteResponse, error)
+	CreateItineraryShareLink(ctx context.Context, in *CreateItineraryShareLinkRequest, opts ...grpc.CallOption) (*CreateItineraryShareLinkResponse, error)
+	ListItineraryShareLinks(ctx context.Context, in *ListItineraryShareLinksRequest, opts ...grpc.CallOption) (*ListItineraryShareLinksResponse, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
+	GetSharedItinerary(ctx context.Context, in *GetSharedItineraryRequest, opts ...grpc.CallOption) (*GetSharedItineraryResponse, error)
+	SetDestinationCountry(ctx context.Context, in *SetDestinationCountryRequest, opts ...grpc.CallOption) (*SetDestinationCountryResponse, error)
+	ListVisitedCountries(ctx context.Context, in *ListVisitedCountriesRequest, opts ...grpc.CallOption) (*ListVisitedCountriesResponse, error)
 }
 
 type itinerariesServiceClient struct {
@@ -92,6 +137,411 @@ func (c *itinerariesServiceClient) LeaveComment(ctx context.Context, in *LeaveCo
 	return out, nil
 }
 
+func (c *itinerariesServiceClient) AddItineraryActivity(ctx context.Context, in *AddItineraryActivityRequest, opts ...grpc.CallOption) (*AddItineraryActivityResponse, error) {
+	out := new(AddItineraryActivityResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/AddItineraryActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) DeleteItineraryActivity(ctx context.Context, in *DeleteItineraryActivityRequest, opts ...grpc.CallOption) (*DeleteItineraryActivityResponse, error) {
+	out := new(DeleteItineraryActivityResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/DeleteItineraryActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) GetItineraryAgenda(ctx context.Context, in *GetItineraryAgendaRequest, opts ...grpc.CallOption) (*GetItineraryAgendaResponse, error) {
+	out := new(GetItineraryAgendaResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/GetItineraryAgenda", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ExportItineraryICS(ctx context.Context, in *ExportItineraryICSRequest, opts ...grpc.CallOption) (*ExportItineraryICSResponse, error) {
+	out := new(ExportItineraryICSResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ExportItineraryICS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ImportItineraryICS(ctx context.Context, in *ImportItineraryICSRequest, opts ...grpc.CallOption) (*ImportItineraryICSResponse, error) {
+	out := new(ImportItineraryICSResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ImportItineraryICS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ForkItinerary(ctx context.Context, in *ForkItineraryRequest, opts ...grpc.CallOption) (*ForkItineraryResponse, error) {
+	out := new(ForkItineraryResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ForkItinerary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) InviteItineraryMember(ctx context.Context, in *InviteItineraryMemberRequest, opts ...grpc.CallOption) (*InviteItineraryMemberResponse, error) {
+	out := new(InviteItineraryMemberResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/InviteItineraryMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) AcceptItineraryInvitation(ctx context.Context, in *AcceptItineraryInvitationRequest, opts ...grpc.CallOption) (*AcceptItineraryInvitationResponse, error) {
+	out := new(AcceptItineraryInvitationResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/AcceptItineraryInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) RemoveItineraryMember(ctx context.Context, in *RemoveItineraryMemberRequest, opts ...grpc.CallOption) (*RemoveItineraryMemberResponse, error) {
+	out := new(RemoveItineraryMemberResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/RemoveItineraryMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListItineraryMembers(ctx context.Context, in *ListItineraryMembersRequest, opts ...grpc.CallOption) (*ListItineraryMembersResponse, error) {
+	out := new(ListItineraryMembersResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListItineraryMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListMyItineraries(ctx context.Context, in *ListMyItinerariesRequest, opts ...grpc.CallOption) (*ListMyItinerariesResponse, error) {
+	out := new(ListMyItinerariesResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListMyItineraries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) LikeItinerary(ctx context.Context, in *LikeItineraryRequest, opts ...grpc.CallOption) (*LikeItineraryResponse, error) {
+	out := new(LikeItineraryResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/LikeItinerary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) UnlikeItinerary(ctx context.Context, in *UnlikeItineraryRequest, opts ...grpc.CallOption) (*UnlikeItineraryResponse, error) {
+	out := new(UnlikeItineraryResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/UnlikeItinerary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListItineraryComments(ctx context.Context, in *ListItineraryCommentsRequest, opts ...grpc.CallOption) (*ListItineraryCommentsResponse, error) {
+	out := new(ListItineraryCommentsResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListItineraryComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) UpdateItineraryComment(ctx context.Context, in *UpdateItineraryCommentRequest, opts ...grpc.CallOption) (*UpdateItineraryCommentResponse, error) {
+	out := new(UpdateItineraryCommentResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/UpdateItineraryComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) DeleteItineraryComment(ctx context.Context, in *DeleteItineraryCommentRequest, opts ...grpc.CallOption) (*DeleteItineraryCommentResponse, error) {
+	out := new(DeleteItineraryCommentResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/DeleteItineraryComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) LinkCatalogDestination(ctx context.Context, in *LinkCatalogDestinationRequest, opts ...grpc.CallOption) (*LinkCatalogDestinationResponse, error) {
+	out := new(LinkCatalogDestinationResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/LinkCatalogDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) AddItineraryExpense(ctx context.Context, in *AddItineraryExpenseRequest, opts ...grpc.CallOption) (*AddItineraryExpenseResponse, error) {
+	out := new(AddItineraryExpenseResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/AddItineraryExpense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) DeleteItineraryExpense(ctx context.Context, in *DeleteItineraryExpenseRequest, opts ...grpc.CallOption) (*DeleteItineraryExpenseResponse, error) {
+	out := new(DeleteItineraryExpenseResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/DeleteItineraryExpense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListItineraryExpenses(ctx context.Context, in *ListItineraryExpensesRequest, opts ...grpc.CallOption) (*ListItineraryExpensesResponse, error) {
+	out := new(ListItineraryExpensesResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListItineraryExpenses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) GetItineraryBudget(ctx context.Context, in *GetItineraryBudgetRequest, opts ...grpc.CallOption) (*GetItineraryBudgetResponse, error) {
+	out := new(GetItineraryBudgetResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/GetItineraryBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) GetSettlement(ctx context.Context, in *GetSettlementRequest, opts ...grpc.CallOption) (*GetSettlementResponse, error) {
+	out := new(GetSettlementResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/GetSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) CreateChecklist(ctx context.Context, in *CreateChecklistRequest, opts ...grpc.CallOption) (*CreateChecklistResponse, error) {
+	out := new(CreateChecklistResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/CreateChecklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListChecklistTemplates(ctx context.Context, in *ListChecklistTemplatesRequest, opts ...grpc.CallOption) (*ListChecklistTemplatesResponse, error) {
+	out := new(ListChecklistTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListChecklistTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ApplyChecklistTemplate(ctx context.Context, in *ApplyChecklistTemplateRequest, opts ...grpc.CallOption) (*ApplyChecklistTemplateResponse, error) {
+	out := new(ApplyChecklistTemplateResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ApplyChecklistTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListChecklists(ctx context.Context, in *ListChecklistsRequest, opts ...grpc.CallOption) (*ListChecklistsResponse, error) {
+	out := new(ListChecklistsResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListChecklists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) DeleteChecklist(ctx context.Context, in *DeleteChecklistRequest, opts ...grpc.CallOption) (*DeleteChecklistResponse, error) {
+	out := new(DeleteChecklistResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/DeleteChecklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*AddChecklistItemResponse, error) {
+	out := new(AddChecklistItemResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/AddChecklistItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest, opts ...grpc.CallOption) (*UpdateChecklistItemResponse, error) {
+	out := new(UpdateChecklistItemResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/UpdateChecklistItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) SetChecklistItemDone(ctx context.Context, in *SetChecklistItemDoneRequest, opts ...grpc.CallOption) (*SetChecklistItemDoneResponse, error) {
+	out := new(SetChecklistItemDoneResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/SetChecklistItemDone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*DeleteChecklistItemResponse, error) {
+	out := new(DeleteChecklistItemResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/DeleteChecklistItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) GetChecklistReminders(ctx context.Context, in *GetChecklistRemindersRequest, opts ...grpc.CallOption) (*GetChecklistRemindersResponse, error) {
+	out := new(GetChecklistRemindersResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/GetChecklistReminders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) CreateItineraryTemplate(ctx context.Context, in *CreateItineraryTemplateRequest, opts ...grpc.CallOption) (*CreateItineraryTemplateResponse, error) {
+	out := new(CreateItineraryTemplateResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/CreateItineraryTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListItineraryTemplates(ctx context.Context, in *ListItineraryTemplatesRequest, opts ...grpc.CallOption) (*ListItineraryTemplatesResponse, error) {
+	out := new(ListItineraryTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListItineraryTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) GetItineraryTemplate(ctx context.Context, in *GetItineraryTemplateRequest, opts ...grpc.CallOption) (*GetItineraryTemplateResponse, error) {
+	out := new(GetItineraryTemplateResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/GetItineraryTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) DeleteItineraryTemplate(ctx context.Context, in *DeleteItineraryTemplateRequest, opts ...grpc.CallOption) (*DeleteItineraryTemplateResponse, error) {
+	out := new(DeleteItineraryTemplateResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/DeleteItineraryTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateResponse, error) {
+	out := new(InstantiateTemplateResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/InstantiateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) SetDestinationCoordinates(ctx context.Context, in *SetDestinationCoordinatesRequest, opts ...grpc.CallOption) (*SetDestinationCoordinatesResponse, error) {
+	out := new(SetDestinationCoordinatesResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/SetDestinationCoordinates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ExportItineraryRoute(ctx context.Context, in *ExportItineraryRouteRequest, opts ...grpc.CallOption) (*ExportItineraryRouteResponse, error) {
+	out := new(ExportItineraryRouteResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ExportItineraryRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) CreateItineraryShareLink(ctx context.Context, in *CreateItineraryShareLinkRequest, opts ...grpc.CallOption) (*CreateItineraryShareLinkResponse, error) {
+	out := new(CreateItineraryShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/CreateItineraryShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListItineraryShareLinks(ctx context.Context, in *ListItineraryShareLinksRequest, opts ...grpc.CallOption) (*ListItineraryShareLinksResponse, error) {
+	out := new(ListItineraryShareLinksResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListItineraryShareLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/RevokeShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) GetSharedItinerary(ctx context.Context, in *GetSharedItineraryRequest, opts ...grpc.CallOption) (*GetSharedItineraryResponse, error) {
+	out := new(GetSharedItineraryResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/GetSharedItinerary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) SetDestinationCountry(ctx context.Context, in *SetDestinationCountryRequest, opts ...grpc.CallOption) (*SetDestinationCountryResponse, error) {
+	out := new(SetDestinationCountryResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/SetDestinationCountry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itinerariesServiceClient) ListVisitedCountries(ctx context.Context, in *ListVisitedCountriesRequest, opts ...grpc.CallOption) (*ListVisitedCountriesResponse, error) {
+	out := new(ListVisitedCountriesResponse)
+	err := c.cc.Invoke(ctx, "/itineraries_service.ItinerariesService/ListVisitedCountries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ItinerariesServiceServer is the server API for ItinerariesService service.
 // All implementations must embed UnimplementedItinerariesServiceServer
 // for forward compatibility
@@ -102,148 +552,1138 @@ type ItinerariesServiceServer interface {
 	ListItineraries(context.Context, *ListItinerariesRequest) (*ListItinerariesResponse, error)
 	GetItinerary(context.Context, *GetItineraryRequest) (*GetItineraryResponse, error)
 	LeaveComment(context.Context, *LeaveCommentRequest) (*LeaveCommentResponse, error)
+	AddItineraryActivity(context.Context, *AddItineraryActivityRequest) (*AddItineraryActivityResponse, error)
+	DeleteItineraryActivity(context.Context, *DeleteItineraryActivityRequest) (*DeleteItineraryActivityResponse, error)
+	GetItineraryAgenda(context.Context, *GetItineraryAgendaRequest) (*GetItineraryAgendaResponse, error)
+	ExportItineraryICS(context.Context, *ExportItineraryICSRequest) (*ExportItineraryICSResponse, error)
+	ImportItineraryICS(context.Context, *ImportItineraryICSRequest) (*ImportItineraryICSResponse, error)
+	ForkItinerary(context.Context, *ForkItineraryRequest) (*ForkItineraryResponse, error)
+	InviteItineraryMember(context.Context, *InviteItineraryMemberRequest) (*InviteItineraryMemberResponse, error)
+	AcceptItineraryInvitation(context.Context, *AcceptItineraryInvitationRequest) (*AcceptItineraryInvitationResponse, error)
+	RemoveItineraryMember(context.Context, *RemoveItineraryMemberRequest) (*RemoveItineraryMemberResponse, error)
+	ListItineraryMembers(context.Context, *ListItineraryMembersRequest) (*ListItineraryMembersResponse, error)
+	ListMyItineraries(context.Context, *ListMyItinerariesRequest) (*ListMyItinerariesResponse, error)
+	LikeItinerary(context.Context, *LikeItineraryRequest) (*LikeItineraryResponse, error)
+	UnlikeItinerary(context.Context, *UnlikeItineraryRequest) (*UnlikeItineraryResponse, error)
+	ListItineraryComments(context.Context, *ListItineraryCommentsRequest) (*ListItineraryCommentsResponse, error)
+	UpdateItineraryComment(context.Context, *UpdateItineraryCommentRequest) (*UpdateItineraryCommentResponse, error)
+	DeleteItineraryComment(context.Context, *DeleteItineraryCommentRequest) (*DeleteItineraryCommentResponse, error)
+	LinkCatalogDestination(context.Context, *LinkCatalogDestinationRequest) (*LinkCatalogDestinationResponse, error)
+	AddItineraryExpense(context.Context, *AddItineraryExpenseRequest) (*AddItineraryExpenseResponse, error)
+	DeleteItineraryExpense(context.Context, *DeleteItineraryExpenseRequest) (*DeleteItineraryExpenseResponse, error)
+	ListItineraryExpenses(context.Context, *ListItineraryExpensesRequest) (*ListItineraryExpensesResponse, error)
+	GetItineraryBudget(context.Context, *GetItineraryBudgetRequest) (*GetItineraryBudgetResponse, error)
+	GetSettlement(context.Context, *GetSettlementRequest) (*GetSettlementResponse, error)
+	CreateChecklist(context.Context, *CreateChecklistRequest) (*CreateChecklistResponse, error)
+	ListChecklistTemplates(context.Context, *ListChecklistTemplatesRequest) (*ListChecklistTemplatesResponse, error)
+	ApplyChecklistTemplate(context.Context, *ApplyChecklistTemplateRequest) (*ApplyChecklistTemplateResponse, error)
+	ListChecklists(context.Context, *ListChecklistsRequest) (*ListChecklistsResponse, error)
+	DeleteChecklist(context.Context, *DeleteChecklistRequest) (*DeleteChecklistResponse, error)
+	AddChecklistItem(context.Context, *AddChecklistItemRequest) (*AddChecklistItemResponse, error)
+	UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error)
+	SetChecklistItemDone(context.Context, *SetChecklistItemDoneRequest) (*SetChecklistItemDoneResponse, error)
+	DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error)
+	GetChecklistReminders(context.Context, *GetChecklistRemindersRequest) (*GetChecklistRemindersResponse, error)
+	CreateItineraryTemplate(context.Context, *CreateItineraryTemplateRequest) (*CreateItineraryTemplateResponse, error)
+	ListItineraryTemplates(context.Context, *ListItineraryTemplatesRequest) (*ListItineraryTemplatesResponse, error)
+	GetItineraryTemplate(context.Context, *GetItineraryTemplateRequest) (*GetItineraryTemplateResponse, error)
+	DeleteItineraryTemplate(context.Context, *DeleteItineraryTemplateRequest) (*DeleteItineraryTemplateResponse, error)
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateResponse, error)
+	SetDestinationCoordinates(context.Context, *SetDestinationCoordinatesRequest) (*SetDestinationCoordinatesResponse, error)
+	ExportItineraryRoute(context.Context, *ExportItineraryRouteRequest) (*ExportItineraryRouteResponse, error)
+	CreateItineraryShareLink(context.Context, *CreateItineraryShareLinkRequest) (*CreateItineraryShareLinkResponse, error)
+	ListItineraryShareLinks(context.Context, *ListItineraryShareLinksRequest) (*ListItineraryShareLinksResponse, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+	GetSharedItinerary(context.Context, *GetSharedItineraryRequest) (*GetSharedItineraryResponse, error)
+	SetDestinationCountry(context.Context, *SetDestinationCountryRequest) (*SetDestinationCountryResponse, error)
+	ListVisitedCountries(context.Context, *ListVisitedCountriesRequest) (*ListVisitedCountriesResponse, error)
+	mustEmbedUnimplementedItinerariesServiceServer()
+}
+
+// UnimplementedItinerariesServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedItinerariesServiceServer struct {
+}
+
+func (UnimplementedItinerariesServiceServer) CreateItinerary(context.Context, *CreateItineraryRequest) (*CreateItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItinerary not implemented")
+}
+func (UnimplementedItinerariesServiceServer) UpdateItinerary(context.Context, *UpdateItineraryRequest) (*UpdateItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItinerary not implemented")
+}
+func (UnimplementedItinerariesServiceServer) DeleteItinerary(context.Context, *DeleteItineraryRequest) (*DeleteItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItinerary not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListItineraries(context.Context, *ListItinerariesRequest) (*ListItinerariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItineraries not implemented")
+}
+func (UnimplementedItinerariesServiceServer) GetItinerary(context.Context, *GetItineraryRequest) (*GetItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItinerary not implemented")
+}
+func (UnimplementedItinerariesServiceServer) LeaveComment(context.Context, *LeaveCommentRequest) (*LeaveCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveComment not implemented")
+}
+func (UnimplementedItinerariesServiceServer) AddItineraryActivity(context.Context, *AddItineraryActivityRequest) (*AddItineraryActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddItineraryActivity not implemented")
+}
+func (UnimplementedItinerariesServiceServer) DeleteItineraryActivity(context.Context, *DeleteItineraryActivityRequest) (*DeleteItineraryActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItineraryActivity not implemented")
+}
+func (UnimplementedItinerariesServiceServer) GetItineraryAgenda(context.Context, *GetItineraryAgendaRequest) (*GetItineraryAgendaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItineraryAgenda not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ExportItineraryICS(context.Context, *ExportItineraryICSRequest) (*ExportItineraryICSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportItineraryICS not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ImportItineraryICS(context.Context, *ImportItineraryICSRequest) (*ImportItineraryICSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportItineraryICS not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ForkItinerary(context.Context, *ForkItineraryRequest) (*ForkItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkItinerary not implemented")
+}
+func (UnimplementedItinerariesServiceServer) InviteItineraryMember(context.Context, *InviteItineraryMemberRequest) (*InviteItineraryMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteItineraryMember not implemented")
+}
+func (UnimplementedItinerariesServiceServer) AcceptItineraryInvitation(context.Context, *AcceptItineraryInvitationRequest) (*AcceptItineraryInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptItineraryInvitation not implemented")
+}
+func (UnimplementedItinerariesServiceServer) RemoveItineraryMember(context.Context, *RemoveItineraryMemberRequest) (*RemoveItineraryMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveItineraryMember not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListItineraryMembers(context.Context, *ListItineraryMembersRequest) (*ListItineraryMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItineraryMembers not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListMyItineraries(context.Context, *ListMyItinerariesRequest) (*ListMyItinerariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyItineraries not implemented")
+}
+func (UnimplementedItinerariesServiceServer) LikeItinerary(context.Context, *LikeItineraryRequest) (*LikeItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeItinerary not implemented")
+}
+func (UnimplementedItinerariesServiceServer) UnlikeItinerary(context.Context, *UnlikeItineraryRequest) (*UnlikeItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeItinerary not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListItineraryComments(context.Context, *ListItineraryCommentsRequest) (*ListItineraryCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItineraryComments not implemented")
+}
+func (UnimplementedItinerariesServiceServer) UpdateItineraryComment(context.Context, *UpdateItineraryCommentRequest) (*UpdateItineraryCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItineraryComment not implemented")
+}
+func (UnimplementedItinerariesServiceServer) DeleteItineraryComment(context.Context, *DeleteItineraryCommentRequest) (*DeleteItineraryCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItineraryComment not implemented")
+}
+func (UnimplementedItinerariesServiceServer) LinkCatalogDestination(context.Context, *LinkCatalogDestinationRequest) (*LinkCatalogDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkCatalogDestination not implemented")
+}
+func (UnimplementedItinerariesServiceServer) AddItineraryExpense(context.Context, *AddItineraryExpenseRequest) (*AddItineraryExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddItineraryExpense not implemented")
+}
+func (UnimplementedItinerariesServiceServer) DeleteItineraryExpense(context.Context, *DeleteItineraryExpenseRequest) (*DeleteItineraryExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItineraryExpense not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListItineraryExpenses(context.Context, *ListItineraryExpensesRequest) (*ListItineraryExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItineraryExpenses not implemented")
+}
+func (UnimplementedItinerariesServiceServer) GetItineraryBudget(context.Context, *GetItineraryBudgetRequest) (*GetItineraryBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItineraryBudget not implemented")
+}
+func (UnimplementedItinerariesServiceServer) GetSettlement(context.Context, *GetSettlementRequest) (*GetSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlement not implemented")
+}
+func (UnimplementedItinerariesServiceServer) CreateChecklist(context.Context, *CreateChecklistRequest) (*CreateChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChecklist not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListChecklistTemplates(context.Context, *ListChecklistTemplatesRequest) (*ListChecklistTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChecklistTemplates not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ApplyChecklistTemplate(context.Context, *ApplyChecklistTemplateRequest) (*ApplyChecklistTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyChecklistTemplate not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListChecklists(context.Context, *ListChecklistsRequest) (*ListChecklistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChecklists not implemented")
+}
+func (UnimplementedItinerariesServiceServer) DeleteChecklist(context.Context, *DeleteChecklistRequest) (*DeleteChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChecklist not implemented")
+}
+func (UnimplementedItinerariesServiceServer) AddChecklistItem(context.Context, *AddChecklistItemRequest) (*AddChecklistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChecklistItem not implemented")
+}
+func (UnimplementedItinerariesServiceServer) UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChecklistItem not implemented")
+}
+func (UnimplementedItinerariesServiceServer) SetChecklistItemDone(context.Context, *SetChecklistItemDoneRequest) (*SetChecklistItemDoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChecklistItemDone not implemented")
+}
+func (UnimplementedItinerariesServiceServer) DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChecklistItem not implemented")
+}
+func (UnimplementedItinerariesServiceServer) GetChecklistReminders(context.Context, *GetChecklistRemindersRequest) (*GetChecklistRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChecklistReminders not implemented")
+}
+func (UnimplementedItinerariesServiceServer) CreateItineraryTemplate(context.Context, *CreateItineraryTemplateRequest) (*CreateItineraryTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItineraryTemplate not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListItineraryTemplates(context.Context, *ListItineraryTemplatesRequest) (*ListItineraryTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItineraryTemplates not implemented")
+}
+func (UnimplementedItinerariesServiceServer) GetItineraryTemplate(context.Context, *GetItineraryTemplateRequest) (*GetItineraryTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItineraryTemplate not implemented")
+}
+func (UnimplementedItinerariesServiceServer) DeleteItineraryTemplate(context.Context, *DeleteItineraryTemplateRequest) (*DeleteItineraryTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItineraryTemplate not implemented")
+}
+func (UnimplementedItinerariesServiceServer) InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateTemplate not implemented")
+}
+func (UnimplementedItinerariesServiceServer) SetDestinationCoordinates(context.Context, *SetDestinationCoordinatesRequest) (*SetDestinationCoordinatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDestinationCoordinates not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ExportItineraryRoute(context.Context, *ExportItineraryRouteRequest) (*ExportItineraryRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportItineraryRoute not implemented")
+}
+func (UnimplementedItinerariesServiceServer) CreateItineraryShareLink(context.Context, *CreateItineraryShareLinkRequest) (*CreateItineraryShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItineraryShareLink not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListItineraryShareLinks(context.Context, *ListItineraryShareLinksRequest) (*ListItineraryShareLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItineraryShareLinks not implemented")
+}
+func (UnimplementedItinerariesServiceServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedItinerariesServiceServer) GetSharedItinerary(context.Context, *GetSharedItineraryRequest) (*GetSharedItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedItinerary not implemented")
+}
+func (UnimplementedItinerariesServiceServer) SetDestinationCountry(context.Context, *SetDestinationCountryRequest) (*SetDestinationCountryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDestinationCountry not implemented")
+}
+func (UnimplementedItinerariesServiceServer) ListVisitedCountries(context.Context, *ListVisitedCountriesRequest) (*ListVisitedCountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVisitedCountries not implemented")
+}
+func (UnimplementedItinerariesServiceServer) mustEmbedUnimplementedItinerariesServiceServer() {}
+
+// UnsafeItinerariesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ItinerariesServiceServer will
+// result in compilation errors.
+type UnsafeItinerariesServiceServer interface {
 	mustEmbedUnimplementedItinerariesServiceServer()
 }
 
-// UnimplementedItinerariesServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedItinerariesServiceServer struct {
+func RegisterItinerariesServiceServer(s grpc.ServiceRegistrar, srv ItinerariesServiceServer) {
+	s.RegisterService(&ItinerariesService_ServiceDesc, srv)
+}
+
+func _ItinerariesService_CreateItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).CreateItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/CreateItinerary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).CreateItinerary(ctx, req.(*CreateItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_UpdateItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).UpdateItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/UpdateItinerary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).UpdateItinerary(ctx, req.(*UpdateItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_DeleteItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).DeleteItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/DeleteItinerary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).DeleteItinerary(ctx, req.(*DeleteItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ListItineraries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItinerariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ListItineraries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ListItineraries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ListItineraries(ctx, req.(*ListItinerariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_GetItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).GetItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/GetItinerary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).GetItinerary(ctx, req.(*GetItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_LeaveComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).LeaveComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/LeaveComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).LeaveComment(ctx, req.(*LeaveCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_AddItineraryActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddItineraryActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).AddItineraryActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/AddItineraryActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).AddItineraryActivity(ctx, req.(*AddItineraryActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_DeleteItineraryActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItineraryActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).DeleteItineraryActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/DeleteItineraryActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).DeleteItineraryActivity(ctx, req.(*DeleteItineraryActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_GetItineraryAgenda_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItineraryAgendaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).GetItineraryAgenda(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/GetItineraryAgenda",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).GetItineraryAgenda(ctx, req.(*GetItineraryAgendaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ExportItineraryICS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportItineraryICSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ExportItineraryICS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ExportItineraryICS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ExportItineraryICS(ctx, req.(*ExportItineraryICSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ImportItineraryICS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportItineraryICSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ImportItineraryICS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ImportItineraryICS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ImportItineraryICS(ctx, req.(*ImportItineraryICSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ForkItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ForkItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ForkItinerary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ForkItinerary(ctx, req.(*ForkItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_InviteItineraryMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteItineraryMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).InviteItineraryMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/InviteItineraryMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).InviteItineraryMember(ctx, req.(*InviteItineraryMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_AcceptItineraryInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptItineraryInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).AcceptItineraryInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/AcceptItineraryInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).AcceptItineraryInvitation(ctx, req.(*AcceptItineraryInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_RemoveItineraryMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItineraryMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).RemoveItineraryMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/RemoveItineraryMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).RemoveItineraryMember(ctx, req.(*RemoveItineraryMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ListItineraryMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItineraryMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ListItineraryMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ListItineraryMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ListItineraryMembers(ctx, req.(*ListItineraryMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ListMyItineraries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyItinerariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ListMyItineraries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ListMyItineraries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ListMyItineraries(ctx, req.(*ListMyItinerariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_LikeItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).LikeItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/LikeItinerary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).LikeItinerary(ctx, req.(*LikeItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_UnlikeItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).UnlikeItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/UnlikeItinerary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).UnlikeItinerary(ctx, req.(*UnlikeItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ListItineraryComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItineraryCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ListItineraryComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ListItineraryComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ListItineraryComments(ctx, req.(*ListItineraryCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_UpdateItineraryComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItineraryCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).UpdateItineraryComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/UpdateItineraryComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).UpdateItineraryComment(ctx, req.(*UpdateItineraryCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_DeleteItineraryComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItineraryCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).DeleteItineraryComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/DeleteItineraryComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).DeleteItineraryComment(ctx, req.(*DeleteItineraryCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_LinkCatalogDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkCatalogDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).LinkCatalogDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/LinkCatalogDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).LinkCatalogDestination(ctx, req.(*LinkCatalogDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_AddItineraryExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddItineraryExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).AddItineraryExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/AddItineraryExpense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).AddItineraryExpense(ctx, req.(*AddItineraryExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_DeleteItineraryExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItineraryExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).DeleteItineraryExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/DeleteItineraryExpense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).DeleteItineraryExpense(ctx, req.(*DeleteItineraryExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ListItineraryExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItineraryExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ListItineraryExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ListItineraryExpenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ListItineraryExpenses(ctx, req.(*ListItineraryExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_GetItineraryBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItineraryBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).GetItineraryBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/GetItineraryBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).GetItineraryBudget(ctx, req.(*GetItineraryBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_GetSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).GetSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/GetSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).GetSettlement(ctx, req.(*GetSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_CreateChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChecklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).CreateChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/CreateChecklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).CreateChecklist(ctx, req.(*CreateChecklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ListChecklistTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecklistTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ListChecklistTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ListChecklistTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ListChecklistTemplates(ctx, req.(*ListChecklistTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ApplyChecklistTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyChecklistTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ApplyChecklistTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ApplyChecklistTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ApplyChecklistTemplate(ctx, req.(*ApplyChecklistTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_ListChecklists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecklistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ListChecklists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ListChecklists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ListChecklists(ctx, req.(*ListChecklistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_DeleteChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChecklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).DeleteChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/DeleteChecklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).DeleteChecklist(ctx, req.(*DeleteChecklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_AddChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).AddChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/AddChecklistItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).AddChecklistItem(ctx, req.(*AddChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_UpdateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).UpdateChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/UpdateChecklistItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).UpdateChecklistItem(ctx, req.(*UpdateChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_SetChecklistItemDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChecklistItemDoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).SetChecklistItemDone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/SetChecklistItemDone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).SetChecklistItemDone(ctx, req.(*SetChecklistItemDoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItinerariesService_DeleteChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).DeleteChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/DeleteChecklistItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).DeleteChecklistItem(ctx, req.(*DeleteChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedItinerariesServiceServer) CreateItinerary(context.Context, *CreateItineraryRequest) (*CreateItineraryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateItinerary not implemented")
+func _ItinerariesService_GetChecklistReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChecklistRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).GetChecklistReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/GetChecklistReminders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).GetChecklistReminders(ctx, req.(*GetChecklistRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedItinerariesServiceServer) UpdateItinerary(context.Context, *UpdateItineraryRequest) (*UpdateItineraryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateItinerary not implemented")
+
+func _ItinerariesService_CreateItineraryTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateItineraryTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).CreateItineraryTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/CreateItineraryTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).CreateItineraryTemplate(ctx, req.(*CreateItineraryTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedItinerariesServiceServer) DeleteItinerary(context.Context, *DeleteItineraryRequest) (*DeleteItineraryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteItinerary not implemented")
+
+func _ItinerariesService_ListItineraryTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItineraryTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ListItineraryTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ListItineraryTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ListItineraryTemplates(ctx, req.(*ListItineraryTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedItinerariesServiceServer) ListItineraries(context.Context, *ListItinerariesRequest) (*ListItinerariesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListItineraries not implemented")
+
+func _ItinerariesService_GetItineraryTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItineraryTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).GetItineraryTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/GetItineraryTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).GetItineraryTemplate(ctx, req.(*GetItineraryTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedItinerariesServiceServer) GetItinerary(context.Context, *GetItineraryRequest) (*GetItineraryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetItinerary not implemented")
+
+func _ItinerariesService_DeleteItineraryTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItineraryTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).DeleteItineraryTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/DeleteItineraryTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).DeleteItineraryTemplate(ctx, req.(*DeleteItineraryTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedItinerariesServiceServer) LeaveComment(context.Context, *LeaveCommentRequest) (*LeaveCommentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveComment not implemented")
+
+func _ItinerariesService_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/InstantiateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedItinerariesServiceServer) mustEmbedUnimplementedItinerariesServiceServer() {}
 
-// UnsafeItinerariesServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ItinerariesServiceServer will
-// result in compilation errors.
-type UnsafeItinerariesServiceServer interface {
-	mustEmbedUnimplementedItinerariesServiceServer()
+func _ItinerariesService_SetDestinationCoordinates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDestinationCoordinatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).SetDestinationCoordinates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/SetDestinationCoordinates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).SetDestinationCoordinates(ctx, req.(*SetDestinationCoordinatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterItinerariesServiceServer(s grpc.ServiceRegistrar, srv ItinerariesServiceServer) {
-	s.RegisterService(&ItinerariesService_ServiceDesc, srv)
+func _ItinerariesService_ExportItineraryRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportItineraryRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItinerariesServiceServer).ExportItineraryRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itineraries_service.ItinerariesService/ExportItineraryRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItinerariesServiceServer).ExportItineraryRoute(ctx, req.(*ExportItineraryRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ItinerariesService_CreateItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateItineraryRequest)
+func _ItinerariesService_CreateItineraryShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateItineraryShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ItinerariesServiceServer).CreateItinerary(ctx, in)
+		return srv.(ItinerariesServiceServer).CreateItineraryShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/itineraries_service.ItinerariesService/CreateItinerary",
+		FullMethod: "/itineraries_service.ItinerariesService/CreateItineraryShareLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItinerariesServiceServer).CreateItinerary(ctx, req.(*CreateItineraryRequest))
+		return srv.(ItinerariesServiceServer).CreateItineraryShareLink(ctx, req.(*CreateItineraryShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ItinerariesService_UpdateItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateItineraryRequest)
+func _ItinerariesService_ListItineraryShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItineraryShareLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ItinerariesServiceServer).UpdateItinerary(ctx, in)
+		return srv.(ItinerariesServiceServer).ListItineraryShareLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/itineraries_service.ItinerariesService/UpdateItinerary",
+		FullMethod: "/itineraries_service.ItinerariesService/ListItineraryShareLinks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItinerariesServiceServer).UpdateItinerary(ctx, req.(*UpdateItineraryRequest))
+		return srv.(ItinerariesServiceServer).ListItineraryShareLinks(ctx, req.(*ListItineraryShareLinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ItinerariesService_DeleteItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteItineraryRequest)
+func _ItinerariesService_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ItinerariesServiceServer).DeleteItinerary(ctx, in)
+		return srv.(ItinerariesServiceServer).RevokeShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/itineraries_service.ItinerariesService/DeleteItinerary",
+		FullMethod: "/itineraries_service.ItinerariesService/RevokeShareLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItinerariesServiceServer).DeleteItinerary(ctx, req.(*DeleteItineraryRequest))
+		return srv.(ItinerariesServiceServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ItinerariesService_ListItineraries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItinerariesRequest)
+func _ItinerariesService_GetSharedItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedItineraryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ItinerariesServiceServer).ListItineraries(ctx, in)
+		return srv.(ItinerariesServiceServer).GetSharedItinerary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/itineraries_service.ItinerariesService/ListItineraries",
+		FullMethod: "/itineraries_service.ItinerariesService/GetSharedItinerary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItinerariesServiceServer).ListItineraries(ctx, req.(*ListItinerariesRequest))
+		return srv.(ItinerariesServiceServer).GetSharedItinerary(ctx, req.(*GetSharedItineraryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ItinerariesService_GetItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetItineraryRequest)
+func _ItinerariesService_SetDestinationCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDestinationCountryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ItinerariesServiceServer).GetItinerary(ctx, in)
+		return srv.(ItinerariesServiceServer).SetDestinationCountry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/itineraries_service.ItinerariesService/GetItinerary",
+		FullMethod: "/itineraries_service.ItinerariesService/SetDestinationCountry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItinerariesServiceServer).GetItinerary(ctx, req.(*GetItineraryRequest))
+		return srv.(ItinerariesServiceServer).SetDestinationCountry(ctx, req.(*SetDestinationCountryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ItinerariesService_LeaveComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveCommentRequest)
+func _ItinerariesService_ListVisitedCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVisitedCountriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ItinerariesServiceServer).LeaveComment(ctx, in)
+		return srv.(ItinerariesServiceServer).ListVisitedCountries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/itineraries_service.ItinerariesService/LeaveComment",
+		FullMethod: "/itineraries_service.ItinerariesService/ListVisitedCountries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItinerariesServiceServer).LeaveComment(ctx, req.(*LeaveCommentRequest))
+		return srv.(ItinerariesServiceServer).ListVisitedCountries(ctx, req.(*ListVisitedCountriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -279,6 +1719,186 @@ var ItinerariesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveComment",
 			Handler:    _ItinerariesService_LeaveComment_Handler,
 		},
+		{
+			MethodName: "AddItineraryActivity",
+			Handler:    _ItinerariesService_AddItineraryActivity_Handler,
+		},
+		{
+			MethodName: "DeleteItineraryActivity",
+			Handler:    _ItinerariesService_DeleteItineraryActivity_Handler,
+		},
+		{
+			MethodName: "GetItineraryAgenda",
+			Handler:    _ItinerariesService_GetItineraryAgenda_Handler,
+		},
+		{
+			MethodName: "ExportItineraryICS",
+			Handler:    _ItinerariesService_ExportItineraryICS_Handler,
+		},
+		{
+			MethodName: "ImportItineraryICS",
+			Handler:    _ItinerariesService_ImportItineraryICS_Handler,
+		},
+		{
+			MethodName: "ForkItinerary",
+			Handler:    _ItinerariesService_ForkItinerary_Handler,
+		},
+		{
+			MethodName: "InviteItineraryMember",
+			Handler:    _ItinerariesService_InviteItineraryMember_Handler,
+		},
+		{
+			MethodName: "AcceptItineraryInvitation",
+			Handler:    _ItinerariesService_AcceptItineraryInvitation_Handler,
+		},
+		{
+			MethodName: "RemoveItineraryMember",
+			Handler:    _ItinerariesService_RemoveItineraryMember_Handler,
+		},
+		{
+			MethodName: "ListItineraryMembers",
+			Handler:    _ItinerariesService_ListItineraryMembers_Handler,
+		},
+		{
+			MethodName: "ListMyItineraries",
+			Handler:    _ItinerariesService_ListMyItineraries_Handler,
+		},
+		{
+			MethodName: "LikeItinerary",
+			Handler:    _ItinerariesService_LikeItinerary_Handler,
+		},
+		{
+			MethodName: "UnlikeItinerary",
+			Handler:    _ItinerariesService_UnlikeItinerary_Handler,
+		},
+		{
+			MethodName: "ListItineraryComments",
+			Handler:    _ItinerariesService_ListItineraryComments_Handler,
+		},
+		{
+			MethodName: "UpdateItineraryComment",
+			Handler:    _ItinerariesService_UpdateItineraryComment_Handler,
+		},
+		{
+			MethodName: "DeleteItineraryComment",
+			Handler:    _ItinerariesService_DeleteItineraryComment_Handler,
+		},
+		{
+			MethodName: "LinkCatalogDestination",
+			Handler:    _ItinerariesService_LinkCatalogDestination_Handler,
+		},
+		{
+			MethodName: "AddItineraryExpense",
+			Handler:    _ItinerariesService_AddItineraryExpense_Handler,
+		},
+		{
+			MethodName: "DeleteItineraryExpense",
+			Handler:    _ItinerariesService_DeleteItineraryExpense_Handler,
+		},
+		{
+			MethodName: "ListItineraryExpenses",
+			Handler:    _ItinerariesService_ListItineraryExpenses_Handler,
+		},
+		{
+			MethodName: "GetItineraryBudget",
+			Handler:    _ItinerariesService_GetItineraryBudget_Handler,
+		},
+		{
+			MethodName: "GetSettlement",
+			Handler:    _ItinerariesService_GetSettlement_Handler,
+		},
+		{
+			MethodName: "CreateChecklist",
+			Handler:    _ItinerariesService_CreateChecklist_Handler,
+		},
+		{
+			MethodName: "ListChecklistTemplates",
+			Handler:    _ItinerariesService_ListChecklistTemplates_Handler,
+		},
+		{
+			MethodName: "ApplyChecklistTemplate",
+			Handler:    _ItinerariesService_ApplyChecklistTemplate_Handler,
+		},
+		{
+			MethodName: "ListChecklists",
+			Handler:    _ItinerariesService_ListChecklists_Handler,
+		},
+		{
+			MethodName: "DeleteChecklist",
+			Handler:    _ItinerariesService_DeleteChecklist_Handler,
+		},
+		{
+			MethodName: "AddChecklistItem",
+			Handler:    _ItinerariesService_AddChecklistItem_Handler,
+		},
+		{
+			MethodName: "UpdateChecklistItem",
+			Handler:    _ItinerariesService_UpdateChecklistItem_Handler,
+		},
+		{
+			MethodName: "SetChecklistItemDone",
+			Handler:    _ItinerariesService_SetChecklistItemDone_Handler,
+		},
+		{
+			MethodName: "DeleteChecklistItem",
+			Handler:    _ItinerariesService_DeleteChecklistItem_Handler,
+		},
+		{
+			MethodName: "GetChecklistReminders",
+			Handler:    _ItinerariesService_GetChecklistReminders_Handler,
+		},
+		{
+			MethodName: "CreateItineraryTemplate",
+			Handler:    _ItinerariesService_CreateItineraryTemplate_Handler,
+		},
+		{
+			MethodName: "ListItineraryTemplates",
+			Handler:    _ItinerariesService_ListItineraryTemplates_Handler,
+		},
+		{
+			MethodName: "GetItineraryTemplate",
+			Handler:    _ItinerariesService_GetItineraryTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteItineraryTemplate",
+			Handler:    _ItinerariesService_DeleteItineraryTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateTemplate",
+			Handler:    _ItinerariesService_InstantiateTemplate_Handler,
+		},
+		{
+			MethodName: "SetDestinationCoordinates",
+			Handler:    _ItinerariesService_SetDestinationCoordinates_Handler,
+		},
+		{
+			MethodName: "ExportItineraryRoute",
+			Handler:    _ItinerariesService_ExportItineraryRoute_Handler,
+		},
+		{
+			MethodName: "CreateItineraryShareLink",
+			Handler:    _ItinerariesService_CreateItineraryShareLink_Handler,
+		},
+		{
+			MethodName: "ListItineraryShareLinks",
+			Handler:    _ItinerariesService_ListItineraryShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _ItinerariesService_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "GetSharedItinerary",
+			Handler:    _ItinerariesService_GetSharedItinerary_Handler,
+		},
+		{
+			MethodName: "SetDestinationCountry",
+			Handler:    _ItinerariesService_SetDestinationCountry_Handler,
+		},
+		{
+			MethodName: "ListVisitedCountries",
+			Handler:    _ItinerariesService_ListVisitedCountries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "itineraries.proto",
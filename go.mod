@@ -8,6 +8,7 @@ require (
 	github.com/redis/go-redis/v9 v9.5.4
 	github.com/spf13/cast v1.6.0
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/sync v0.8.0
 	google.golang.org/grpc v1.65.0
 	google.golang.org/protobuf v1.34.2
 )
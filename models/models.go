@@ -1,17 +1,32 @@
 package models
 
+import (
+	pb "content-service/generated/itineraries"
+	"time"
+)
+
 type ItineraryDestination struct {
-	ID          string
-	ItineraryId string
-	Name        string
-	StartDate   string
-	EndDate     string
+	ID                   string
+	ItineraryId          string
+	Name                 string
+	StartDate            string
+	EndDate              string
+	CatalogDestinationId string
+	Coordinates          *GeoPoint
+	CountryCode          string
 }
 
 type ItineraryActivity struct {
 	ID            string
 	DestinationId string
 	Activity      string
+	Day           string
+	StartTime     string
+	EndTime       string
+	TimeZone      string
+	Location      string
+	Notes         string
+	Coordinates   *GeoPoint
 }
 
 type StoryTag struct {
@@ -20,8 +35,204 @@ type StoryTag struct {
 }
 
 type Result struct {
-	ID        string
-	Name      string
-	StartDate string
-	EndDate   string
+	ID                   string
+	Name                 string
+	StartDate            string
+	EndDate              string
+	CatalogDestinationId string
+	Coordinates          *GeoPoint
+	CountryCode          string
+}
+
+// GeoPoint is a WGS 84 position in decimal degrees.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// GeoPointFromPb converts an optional point of an itinerary request.
+func GeoPointFromPb(p *pb.GeoPoint) *GeoPoint {
+	if p == nil {
+		return nil
+	}
+
+	return &GeoPoint{Latitude: p.Latitude, Longitude: p.Longitude}
+}
+
+// ItineraryExpense is money actually spent on a trip. Amounts are kept in
+// cents to avoid rounding errors while summing.
+type ItineraryExpense struct {
+	ID            string
+	ItineraryId   string
+	DestinationId string
+	Category      string
+	AmountCents   int64
+	Currency      string
+	Description   string
+	SpentOn       string
+	CreatedBy     string
+	CreatedAt     string
+	PaidBy        string
+	SplitType     string
+	Participants  []ExpenseShare
+}
+
+// ExpenseShare is one participant of a shared expense. Shares is used by the
+// shares split, AmountCents by the exact split.
+type ExpenseShare struct {
+	UserId      string
+	Shares      int32
+	AmountCents int64
+}
+
+// StayCost is a stay of an itinerary together with the daily average of the
+// catalog destination it is linked to. DailyCostCents is -1 when the stay is
+// not linked or the destination has no average cost.
+type StayCost struct {
+	DestinationId        string
+	Name                 string
+	CatalogDestinationId string
+	Days                 int32
+	DailyCostCents       int64
+	Currency             string
+}
+
+// Itinerary member roles, from most to least privileged.
+const (
+	RoleOwner  = "owner"
+	RoleEditor = "editor"
+	RoleViewer = "viewer"
+)
+
+// Itinerary membership states.
+const (
+	MemberInvited  = "invited"
+	MemberAccepted = "accepted"
+)
+
+// Expense categories.
+const (
+	ExpenseAccommodation = "accommodation"
+	ExpenseTransport     = "transport"
+	ExpenseFood          = "food"
+	ExpenseActivities    = "activities"
+	ExpenseShopping      = "shopping"
+	ExpenseOther         = "other"
+)
+
+// ExchangeRate is how many units of Currency one unit of the base currency
+// buys on RateDate.
+type ExchangeRate struct {
+	Currency string
+	Rate     float64
+	RateDate string
+}
+
+// How an expense is split between its participants. SplitNone is a personal
+// expense of the payer.
+const (
+	SplitNone   = "none"
+	SplitEqual  = "equal"
+	SplitShares = "shares"
+	SplitExact  = "exact"
+)
+
+// Orders of the itinerary list.
+const (
+	SortRecent  = "recent"
+	SortPopular = "popular"
+)
+
+// Orders of the destination review list, besides SortRecent.
+const (
+	SortHelpful = "helpful"
+	SortHighest = "highest"
+	SortLowest  = "lowest"
+)
+
+// Cost bands of a destination, by its average cost per day converted into
+// the base currency.
+const (
+	CostBudget    = "budget"
+	CostModerate  = "moderate"
+	CostExpensive = "expensive"
+	CostLuxury    = "luxury"
+)
+
+// Crowd levels of a destination in a month, least crowded first.
+const (
+	CrowdLow    = "low"
+	CrowdMedium = "medium"
+	CrowdHigh   = "high"
+)
+
+var CrowdLevels = []string{CrowdLow, CrowdMedium, CrowdHigh}
+
+// Typical temperature bands of a destination in a month, coldest first.
+const (
+	TemperatureCold = "cold"
+	TemperatureCool = "cool"
+	TemperatureMild = "mild"
+	TemperatureWarm = "warm"
+	TemperatureHot  = "hot"
+)
+
+var TemperatureBands = []string{TemperatureCold, TemperatureCool, TemperatureMild, TemperatureWarm, TemperatureHot}
+
+// Kinds of catalog destinations, broadest first. A destination can only sit
+// under a destination of a broader kind.
+const (
+	KindCountry = "country"
+	KindRegion  = "region"
+	KindCity    = "city"
+	KindPOI     = "poi"
+)
+
+var DestinationKinds = []string{KindCountry, KindRegion, KindCity, KindPOI}
+
+// VisitedStop is a stop of a finished trip with the country and catalog
+// destination it resolves to. Either of them may be empty.
+type VisitedStop struct {
+	ItineraryId     string
+	CountryCode     string
+	CountryName     string
+	DestinationId   string
+	DestinationName string
+	StartDate       string
+	EndDate         string
+}
+
+// GeotaggedStory is a story that names the country or catalog destination it
+// was written about.
+type GeotaggedStory struct {
+	ID              string
+	CountryCode     string
+	CountryName     string
+	DestinationId   string
+	DestinationName string
+	VisitedOn       string
+}
+
+// Kinds of engagement that make a destination popular.
+const (
+	EngagementStory     = "story"
+	EngagementLike      = "like"
+	EngagementComment   = "comment"
+	EngagementItinerary = "itinerary"
+	EngagementBookmark  = "bookmark"
+)
+
+// Engagement counts one kind of activity around a destination on one day.
+type Engagement struct {
+	DestinationId string
+	Kind          string
+	Day           time.Time
+	Count         int
+}
+
+// DestinationScore is the computed popularity of a destination.
+type DestinationScore struct {
+	DestinationId  string
+	Score          int
+	TrendingReason string
 }
@@ -0,0 +1,125 @@
+// Package popularity scores destinations from the engagement around them.
+// Recent activity counts more: the weight of an event halves every HalfLife.
+package popularity
+
+import (
+	"content-service/models"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// HalfLife is how long it takes an event to lose half of its weight.
+const HalfLife = 14 * 24 * time.Hour
+
+// Window is how far back engagement is taken into account. Older events
+// weigh less than 3% of a fresh one.
+const Window = 5 * HalfLife
+
+const (
+	week = 7 * 24 * time.Hour
+	// minTrendCount and minTrendGrowth keep small numbers from producing
+	// reasons like "+100% stories this week" out of two stories.
+	minTrendCount  = 3
+	minTrendGrowth = 0.2
+)
+
+// weights says how much one event of each kind adds to the score.
+var weights = map[string]float64{
+	models.EngagementStory:     10,
+	models.EngagementItinerary: 8,
+	models.EngagementBookmark:  6,
+	models.EngagementComment:   4,
+	models.EngagementLike:      2,
+}
+
+var nouns = map[string]string{
+	models.EngagementStory:     "stories",
+	models.EngagementItinerary: "itineraries",
+	models.EngagementBookmark:  "bookmarks",
+	models.EngagementComment:   "comments",
+	models.EngagementLike:      "likes",
+}
+
+// Score computes the popularity of every destination engagement is given
+// for, ordered by destination. The trending reason names the kind of
+// engagement that grew the most this week compared to the week before, and is
+// empty when nothing grew noticeably.
+func Score(engagement []models.Engagement, now time.Time) []models.DestinationScore {
+	type tally struct {
+		score    float64
+		thisWeek map[string]int
+		lastWeek map[string]int
+	}
+
+	tallies := map[string]*tally{}
+	for _, e := range engagement {
+		age := now.Sub(e.Day)
+		if age < 0 {
+			age = 0
+		}
+		if age > Window {
+			continue
+		}
+
+		t := tallies[e.DestinationId]
+		if t == nil {
+			t = &tally{thisWeek: map[string]int{}, lastWeek: map[string]int{}}
+			tallies[e.DestinationId] = t
+		}
+		t.score += weights[e.Kind] * float64(e.Count) * math.Pow(0.5, float64(age)/float64(HalfLife))
+
+		switch {
+		case age < week:
+			t.thisWeek[e.Kind] += e.Count
+		case age < 2*week:
+			t.lastWeek[e.Kind] += e.Count
+		}
+	}
+
+	var scores []models.DestinationScore
+	for id, t := range tallies {
+		scores = append(scores, models.DestinationScore{
+			DestinationId:  id,
+			Score:          int(math.Round(t.score)),
+			TrendingReason: trendingReason(t.thisWeek, t.lastWeek),
+		})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].DestinationId < scores[j].DestinationId
+	})
+
+	return scores
+}
+
+func trendingReason(thisWeek, lastWeek map[string]int) string {
+	best, bestGain := "", 0.0
+	for _, kind := range []string{
+		models.EngagementStory,
+		models.EngagementItinerary,
+		models.EngagementBookmark,
+		models.EngagementComment,
+		models.EngagementLike,
+	} {
+		now, before := thisWeek[kind], lastWeek[kind]
+		if now < minTrendCount || float64(now) < float64(before)*(1+minTrendGrowth) {
+			continue
+		}
+
+		if gain := float64(now-before) * weights[kind]; gain > bestGain {
+			best, bestGain = kind, gain
+		}
+	}
+
+	if best == "" {
+		return ""
+	}
+	if lastWeek[best] == 0 {
+		return fmt.Sprintf("%d new %s this week", thisWeek[best], nouns[best])
+	}
+
+	growth := math.Round(100 * float64(thisWeek[best]-lastWeek[best]) / float64(lastWeek[best]))
+
+	return fmt.Sprintf("+%d%% %s this week", int(growth), nouns[best])
+}
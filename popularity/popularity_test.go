@@ -0,0 +1,69 @@
+package popularity
+
+import (
+	"content-service/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreDecay(t *testing.T) {
+	now := time.Date(2024, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	scores := Score([]models.Engagement{
+		{DestinationId: "fresh", Kind: models.EngagementStory, Day: now, Count: 1},
+		{DestinationId: "old", Kind: models.EngagementStory, Day: now.Add(-HalfLife), Count: 1},
+		{DestinationId: "older", Kind: models.EngagementStory, Day: now.Add(-2 * HalfLife), Count: 1},
+		{DestinationId: "gone", Kind: models.EngagementStory, Day: now.Add(-Window - time.Hour), Count: 100},
+	}, now)
+
+	assert.Equal(t, []models.DestinationScore{
+		{DestinationId: "fresh", Score: 10},
+		{DestinationId: "old", Score: 5},
+		{DestinationId: "older", Score: 3},
+	}, scores)
+}
+
+func TestScoreWeights(t *testing.T) {
+	now := time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+
+	scores := Score([]models.Engagement{
+		{DestinationId: "d", Kind: models.EngagementStory, Day: now, Count: 1},
+		{DestinationId: "d", Kind: models.EngagementItinerary, Day: now, Count: 1},
+		{DestinationId: "d", Kind: models.EngagementBookmark, Day: now, Count: 1},
+		{DestinationId: "d", Kind: models.EngagementComment, Day: now, Count: 1},
+		{DestinationId: "d", Kind: models.EngagementLike, Day: now, Count: 1},
+	}, now)
+
+	assert.Equal(t, 30, scores[0].Score)
+	assert.Empty(t, scores[0].TrendingReason)
+}
+
+func TestTrendingReason(t *testing.T) {
+	now := time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+	lastWeek := now.AddDate(0, 0, -10)
+
+	scores := Score([]models.Engagement{
+		// itineraries grew from 5 to 7, likes from 10 to 11
+		{DestinationId: "growing", Kind: models.EngagementItinerary, Day: lastWeek, Count: 5},
+		{DestinationId: "growing", Kind: models.EngagementItinerary, Day: now, Count: 7},
+		{DestinationId: "growing", Kind: models.EngagementLike, Day: lastWeek, Count: 10},
+		{DestinationId: "growing", Kind: models.EngagementLike, Day: now, Count: 11},
+
+		{DestinationId: "new", Kind: models.EngagementStory, Day: now, Count: 3},
+
+		{DestinationId: "quiet", Kind: models.EngagementStory, Day: now, Count: 2},
+		{DestinationId: "quiet", Kind: models.EngagementComment, Day: lastWeek, Count: 8},
+		{DestinationId: "quiet", Kind: models.EngagementComment, Day: now, Count: 8},
+	}, now)
+
+	reasons := map[string]string{}
+	for _, s := range scores {
+		reasons[s.DestinationId] = s.TrendingReason
+	}
+
+	assert.Equal(t, "+40% itineraries this week", reasons["growing"])
+	assert.Equal(t, "3 new stories this week", reasons["new"])
+	assert.Empty(t, reasons["quiet"])
+}
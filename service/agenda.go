@@ -0,0 +1,216 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	dateLayout  = "2006-01-02"
+	clockLayout = "15:04"
+)
+
+// agendaEntry is one scheduled activity together with the stay it belongs to.
+type agendaEntry struct {
+	Destination models.Result
+	Activity    models.ItineraryActivity
+}
+
+type agendaSlot struct {
+	date    string
+	untimed bool
+	start   time.Time
+	item    *pb.AgendaItem
+}
+
+// buildAgenda orders activities chronologically and groups them by day.
+// Every activity is anchored to its own IANA time zone, so ordering works on
+// absolute instants even when stays span several zones. When viewer is set
+// the times (and therefore the day an activity falls on) are shown in that
+// zone, otherwise in the zone the activity was planned in. Activities without
+// a day are left out; activities without a start time open their day.
+func buildAgenda(entries []agendaEntry, viewer *time.Location) ([]*pb.AgendaDay, error) {
+	var slots []agendaSlot
+
+	for _, e := range entries {
+		act := e.Activity
+		if act.Day == "" {
+			continue
+		}
+
+		loc, err := loadZone(act.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+
+		item := &pb.AgendaItem{
+			ActivityId:       act.ID,
+			DestinationId:    e.Destination.ID,
+			DestinationName:  e.Destination.Name,
+			Title:            act.Activity,
+			OriginalTimeZone: loc.String(),
+			Location:         act.Location,
+			Notes:            act.Notes,
+		}
+
+		if act.StartTime == "" {
+			day, err := time.ParseInLocation(dateLayout, act.Day, loc)
+			if err != nil {
+				return nil, err
+			}
+			item.TimeZone = loc.String()
+			slots = append(slots, agendaSlot{date: act.Day, untimed: true, start: day, item: item})
+			continue
+		}
+
+		start, end, err := activityInterval(act, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		display := loc
+		if viewer != nil {
+			display = viewer
+		}
+
+		item.TimeZone = display.String()
+		item.StartTime = start.In(display).Format(time.RFC3339)
+		if !end.IsZero() {
+			item.EndTime = end.In(display).Format(time.RFC3339)
+		}
+
+		slots = append(slots, agendaSlot{date: start.In(display).Format(dateLayout), start: start, item: item})
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		a, b := slots[i], slots[j]
+		if a.date != b.date {
+			return a.date < b.date
+		}
+		if a.untimed != b.untimed {
+			return a.untimed
+		}
+		if !a.start.Equal(b.start) {
+			return a.start.Before(b.start)
+		}
+		return a.item.Title < b.item.Title
+	})
+
+	var days []*pb.AgendaDay
+	for _, slot := range slots {
+		if len(days) == 0 || days[len(days)-1].Date != slot.date {
+			days = append(days, &pb.AgendaDay{Date: slot.date})
+		}
+		day := days[len(days)-1]
+		day.Items = append(day.Items, slot.item)
+	}
+
+	return days, nil
+}
+
+// activityInterval resolves the local day and clock times of an activity to
+// absolute instants. An end time that is not after the start time is taken
+// to be on the following day, e.g. a 22:00-01:00 night tour.
+func activityInterval(act models.ItineraryActivity, loc *time.Location) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(dateLayout+" "+clockLayout, act.Day+" "+act.StartTime, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if act.EndTime == "" {
+		return start, time.Time{}, nil
+	}
+
+	endDay := act.Day
+	if act.EndTime <= act.StartTime {
+		next, err := time.Parse(dateLayout, act.Day)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		endDay = next.AddDate(0, 0, 1).Format(dateLayout)
+	}
+
+	end, err := time.ParseInLocation(dateLayout+" "+clockLayout, endDay+" "+act.EndTime, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return start, end, nil
+}
+
+func loadZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(name)
+}
+
+// validateActivity checks the schedule fields of an activity that is about to
+// be stored for a stay running from stayStart to stayEnd (YYYY-MM-DD).
+func validateActivity(act *pb.Activity, stayStart, stayEnd string) error {
+	if act.Title == "" {
+		return fmt.Errorf("activity title is required")
+	}
+
+	if _, err := loadZone(act.TimeZone); err != nil {
+		return fmt.Errorf("unknown time zone %q", act.TimeZone)
+	}
+
+	if err := validateGeoPoint(act.Coordinates); err != nil {
+		return fmt.Errorf("activity %q: %w", act.Title, err)
+	}
+
+	if act.Day == "" {
+		if act.StartTime != "" || act.EndTime != "" {
+			return fmt.Errorf("activity %q has a time but no day", act.Title)
+		}
+		return nil
+	}
+
+	day, err := time.Parse(dateLayout, act.Day)
+	if err != nil {
+		return fmt.Errorf("invalid day %q, expected YYYY-MM-DD", act.Day)
+	}
+
+	if len(stayStart) >= len(dateLayout) && len(stayEnd) >= len(dateLayout) {
+		from, errFrom := time.Parse(dateLayout, stayStart[:len(dateLayout)])
+		to, errTo := time.Parse(dateLayout, stayEnd[:len(dateLayout)])
+		if errFrom == nil && errTo == nil && (day.Before(from) || day.After(to)) {
+			return fmt.Errorf("day %s of activity %q is outside the stay %s - %s", act.Day, act.Title, stayStart[:len(dateLayout)], stayEnd[:len(dateLayout)])
+		}
+	}
+
+	if act.StartTime != "" && !isClock(act.StartTime) {
+		return fmt.Errorf("invalid start time %q, expected HH:MM", act.StartTime)
+	}
+
+	if act.EndTime != "" {
+		if act.StartTime == "" {
+			return fmt.Errorf("activity %q has an end time but no start time", act.Title)
+		}
+		if !isClock(act.EndTime) {
+			return fmt.Errorf("invalid end time %q, expected HH:MM", act.EndTime)
+		}
+	}
+
+	return nil
+}
+
+// isClock reports whether s is a zero padded 24-hour HH:MM time, the form
+// the database returns and the agenda compares.
+func isClock(s string) bool {
+	t, err := time.Parse(clockLayout, s)
+
+	return err == nil && t.Format(clockLayout) == s
+}
+
+// isDate reports whether s is a YYYY-MM-DD calendar date.
+func isDate(s string) bool {
+	t, err := time.Parse(dateLayout, s)
+
+	return err == nil && t.Format(dateLayout) == s
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildAgendaOrdersAcrossTimeZones(t *testing.T) {
+	lisbon := models.Result{ID: "d1", Name: "Lisbon"}
+	tokyo := models.Result{ID: "d2", Name: "Tokyo"}
+
+	entries := []agendaEntry{
+		{Destination: tokyo, Activity: models.ItineraryActivity{ID: "a3", Activity: "Sushi breakfast", Day: "2024-05-02", StartTime: "08:00", EndTime: "09:00", TimeZone: "Asia/Tokyo"}},
+		{Destination: lisbon, Activity: models.ItineraryActivity{ID: "a2", Activity: "Fado night", Day: "2024-05-01", StartTime: "22:00", EndTime: "01:00", TimeZone: "Europe/Lisbon"}},
+		{Destination: lisbon, Activity: models.ItineraryActivity{ID: "a1", Activity: "Tram 28", Day: "2024-05-01", StartTime: "10:00", TimeZone: "Europe/Lisbon"}},
+		{Destination: lisbon, Activity: models.ItineraryActivity{ID: "a0", Activity: "Pack", Day: "2024-05-01", TimeZone: "Europe/Lisbon"}},
+		{Destination: lisbon, Activity: models.ItineraryActivity{ID: "x", Activity: "Someday"}},
+	}
+
+	days, err := buildAgenda(entries, nil)
+	assert.NoError(t, err)
+	assert.Len(t, days, 2)
+
+	assert.Equal(t, "2024-05-01", days[0].Date)
+	assert.Equal(t, []string{"a0", "a1", "a2"}, activityIds(days[0].Items))
+	assert.Equal(t, "2024-05-01T22:00:00+01:00", days[0].Items[2].StartTime)
+	assert.Equal(t, "2024-05-02T01:00:00+01:00", days[0].Items[2].EndTime)
+
+	assert.Equal(t, "2024-05-02", days[1].Date)
+	assert.Equal(t, "2024-05-02T08:00:00+09:00", days[1].Items[0].StartTime)
+	assert.Equal(t, "Asia/Tokyo", days[1].Items[0].TimeZone)
+}
+
+func TestBuildAgendaInViewerTimeZone(t *testing.T) {
+	utc := time.UTC
+	entries := []agendaEntry{
+		// 08:00 in Tokyo is 23:00 UTC on the previous day, so it comes
+		// before a 10:00 Lisbon activity planned for the same calendar day.
+		{Destination: models.Result{ID: "d2"}, Activity: models.ItineraryActivity{ID: "tokyo", Activity: "Breakfast", Day: "2024-05-02", StartTime: "08:00", TimeZone: "Asia/Tokyo"}},
+		{Destination: models.Result{ID: "d1"}, Activity: models.ItineraryActivity{ID: "lisbon", Activity: "Museum", Day: "2024-05-02", StartTime: "10:00", TimeZone: "Europe/Lisbon"}},
+	}
+
+	days, err := buildAgenda(entries, utc)
+	assert.NoError(t, err)
+	assert.Len(t, days, 2)
+	assert.Equal(t, "2024-05-01", days[0].Date)
+	assert.Equal(t, "2024-05-01T23:00:00Z", days[0].Items[0].StartTime)
+	assert.Equal(t, "Asia/Tokyo", days[0].Items[0].OriginalTimeZone)
+	assert.Equal(t, "2024-05-02", days[1].Date)
+	assert.Equal(t, "2024-05-02T09:00:00Z", days[1].Items[0].StartTime)
+}
+
+func TestValidateActivity(t *testing.T) {
+	ok := &pb.Activity{Title: "Hike", Day: "2024-05-02", StartTime: "07:30", EndTime: "12:00", TimeZone: "Europe/Zurich"}
+	assert.NoError(t, validateActivity(ok, "2024-05-01", "2024-05-03"))
+
+	assert.Error(t, validateActivity(&pb.Activity{Title: "Hike", TimeZone: "Mars/Base"}, "", ""))
+	assert.Error(t, validateActivity(&pb.Activity{Title: "Hike", Day: "2024-05-09"}, "2024-05-01", "2024-05-03"))
+	assert.Error(t, validateActivity(&pb.Activity{Title: "Hike", Day: "2024-05-02", StartTime: "7pm"}, "2024-05-01", "2024-05-03"))
+	assert.Error(t, validateActivity(&pb.Activity{Title: "Hike", Day: "2024-05-02", StartTime: "7:30"}, "2024-05-01", "2024-05-03"))
+	assert.Error(t, validateActivity(&pb.Activity{Title: "Hike", StartTime: "07:00"}, "", ""))
+	assert.Error(t, validateActivity(&pb.Activity{Day: "2024-05-02"}, "", ""))
+}
+
+func activityIds(items []*pb.AgendaItem) []string {
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ActivityId)
+	}
+	return ids
+}
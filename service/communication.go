@@ -6,6 +6,7 @@ import (
 	"content-service/storage/postgres"
 	"context"
 	"log/slog"
+	"time"
 )
 
 type CommunicationService struct {
@@ -97,7 +98,7 @@ func (s *CommunicationService) GetUserStatics(ctx context.Context, in *pb.GetUse
 		return nil, err
 	}
 
-	totalCountryViseted, err := s.ItineraryRepo.TotalCountriesVisited(in.UserId)
+	totalCountryViseted, err := s.ItineraryRepo.TotalCountriesVisited(in.UserId, time.Now().UTC().Format(dateLayout))
 	if err != nil {
 		s.Logger.Error("Xatolik userning sayohat qilgan davlatlar sonini olishda", slog.String("error", err.Error()))
 		return nil, err
@@ -0,0 +1,99 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ItineraryService) SetDestinationCountry(ctx context.Context, in *pb.SetDestinationCountryRequest) (*pb.SetDestinationCountryResponse, error) {
+	code := strings.ToUpper(in.CountryCode)
+	if code != "" && !isCountryCode(code) {
+		return nil, status.Error(codes.InvalidArgument, "country_code must be an ISO 3166-1 alpha-2 code")
+	}
+
+	destination, err := s.ItineraryRepo.GetItineraryDestination(in.DestinationId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err := s.authorize(destination.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+	if err := s.checkCountry(code); err != nil {
+		return nil, err
+	}
+
+	if err := s.ItineraryRepo.SetDestinationCountry(destination.ID, code); err != nil {
+		s.Logger.Error("Xatolik sayohat manzilining davlatini saqlashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.SetDestinationCountryResponse{
+		DestinationId: destination.ID,
+		CountryCode:   code,
+	}, nil
+}
+
+// ListVisitedCountries lists the countries of the trips a user has finished,
+// with the first and last day spent in each of them.
+func (s *ItineraryService) ListVisitedCountries(ctx context.Context, in *pb.ListVisitedCountriesRequest) (*pb.ListVisitedCountriesResponse, error) {
+	if in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	countries, err := s.ItineraryRepo.ListVisitedCountries(in.UserId, time.Now().UTC().Format(dateLayout))
+	if err != nil {
+		s.Logger.Error("Xatolik userning sayohat qilgan davlatlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ListVisitedCountriesResponse{
+		Countries: countries,
+		Total:     int32(len(countries)),
+	}, nil
+}
+
+// checkCountry makes sure a country code is known, an empty code is fine.
+func (s *ItineraryService) checkCountry(code string) error {
+	if code == "" {
+		return nil
+	}
+
+	_, err := s.ItineraryRepo.GetCountryName(code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.InvalidArgument, "unknown country code %q", code)
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik davlat kodini tekshirishda", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// isCountryCode reports whether s looks like an ISO 3166-1 alpha-2 code.
+func isCountryCode(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"content-service/currency"
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"content-service/storage/postgres"
+	"context"
+	"log/slog"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const roleAdmin = "admin"
+
+// requireAdmin only lets the administrators listed in AdminIds through.
+// The auth service has no notion of roles, so this service keeps its own.
+func (s *DestinationService) requireAdmin(userId string) error {
+	if userId == "" {
+		return status.Error(codes.Unauthenticated, "user_id is required")
+	}
+
+	if !slices.Contains(s.AdminIds, userId) {
+		return status.Error(codes.PermissionDenied, "admin role is required")
+	}
+
+	return nil
+}
+
+func (s *DestinationService) UpdateExchangeRates(ctx context.Context, in *pb.UpdateExchangeRatesRequest) (*pb.UpdateExchangeRatesResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	var rates []models.ExchangeRate
+	for _, r := range in.Rates {
+		rate := models.ExchangeRate{Currency: r.Currency, Rate: r.Rate, RateDate: r.RateDate}
+		if err := currency.Validate(rate.Currency); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if rate.Currency == currency.Base || rate.Rate <= 0 || !isDate(rate.RateDate) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid rate for %s", rate.Currency)
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := s.RateRepo.UpsertRates(rates); err != nil {
+		s.Logger.Error("Xatolik valyuta kurslarini saqlashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.UpdateExchangeRatesResponse{Updated: int32(len(rates))}, nil
+}
+
+func (s *DestinationService) ListExchangeRates(ctx context.Context, in *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesResponse, error) {
+	rates, err := s.RateRepo.GetLatestRates()
+	if err != nil {
+		s.Logger.Error("Xatolik valyuta kurslarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	resp := pb.ListExchangeRatesResponse{Base: currency.Base}
+	for _, r := range rates {
+		resp.Rates = append(resp.Rates, &pb.ExchangeRate{Currency: r.Currency, Rate: r.Rate, RateDate: r.RateDate})
+	}
+
+	return &resp, nil
+}
+
+// loadRates returns the latest exchange rates, making sure target can be
+// converted into. A nil table is returned when no target is requested.
+func loadRates(repo *postgres.ExchangeRateRepo, logger *slog.Logger, target string) (*currency.Rates, error) {
+	if target == "" {
+		return nil, nil
+	}
+
+	if err := currency.Validate(target); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := repo.GetLatestRates()
+	if err != nil {
+		logger.Error("Xatolik valyuta kurslarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rates := currency.New(list)
+	if _, _, err := rates.Convert(0, currency.Base, target); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return rates, nil
+}
+
+// convertCost converts a daily cost into target. Costs in a currency without
+// a known rate are left unconverted.
+func convertCost(rates *currency.Rates, cost float64, from, target string) *pb.ConvertedAmount {
+	if rates == nil || from == "" {
+		return nil
+	}
+
+	cents, rateDate, err := rates.Convert(toCents(cost), from, target)
+	if err != nil {
+		return nil
+	}
+
+	return &pb.ConvertedAmount{Amount: fromCents(cents), Currency: target, RateDate: rateDate}
+}
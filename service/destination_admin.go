@@ -0,0 +1,295 @@
+package service
+
+import (
+	"content-service/currency"
+	pb "content-service/generated/destination"
+	"content-service/storage/postgres"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *DestinationService) AddDestination(ctx context.Context, in *pb.AddDestinationRequest) (*pb.AddDestionationResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	kind, err := normalizeDestinationKind(in.Kind)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	in.Kind = kind
+
+	if err := s.resolveParentName(in); err != nil {
+		return nil, err
+	}
+	country, err := s.placeDestination("", in.Kind, in.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(in.Country) == "" {
+		in.Country = country
+	}
+
+	in.CountryCode = strings.ToUpper(in.CountryCode)
+	in.Language = strings.ToLower(in.Language)
+	if err := validateDestination(in.Name, in.Country, in.CountryCode, in.Currency, in.Language, in.AverageCostPerDay); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateDestinationPoint(in.Coordinates); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, items := range [][]string{in.Activities, in.TopAttractions} {
+		for _, text := range items {
+			if strings.TrimSpace(text) == "" {
+				return nil, status.Error(codes.InvalidArgument, "activities and top attractions must not be empty")
+			}
+		}
+	}
+	if err := s.checkCountry(in.CountryCode); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.DestinationRepo.CreateDestination(in)
+	if errors.Is(err, postgres.ErrDuplicateDestination) {
+		return nil, status.Error(codes.AlreadyExists, "a destination with this name and country already exists")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini qo'shishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	s.invalidateTrending(ctx)
+
+	return resp, nil
+}
+
+func (s *DestinationService) UpdateDestination(ctx context.Context, in *pb.UpdateDestinationRequest) (*pb.UpdateDestinationResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	if in.ClearParent && in.ParentId != "" {
+		return nil, status.Error(codes.InvalidArgument, "parent_id and clear_parent cannot be used together")
+	}
+	if in.ClearCoordinates && in.Coordinates != nil {
+		return nil, status.Error(codes.InvalidArgument, "coordinates and clear_coordinates cannot be used together")
+	}
+
+	// Kind, parent and the other fields left empty keep what is stored.
+	kind, parentId, _, err := s.DestinationRepo.GetDestinationPlace(in.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	if in.Kind != "" {
+		if kind, err = normalizeDestinationKind(in.Kind); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		in.Kind = kind
+	}
+	if in.ParentId != "" || in.ClearParent {
+		parentId = in.ParentId
+	}
+
+	country, err := s.placeDestination(in.Id, kind, parentId)
+	if err != nil {
+		return nil, err
+	}
+	in.Name = strings.TrimSpace(in.Name)
+	in.Country = strings.TrimSpace(in.Country)
+	if in.Country == "" {
+		in.Country = country
+	}
+
+	in.CountryCode = strings.ToUpper(in.CountryCode)
+	in.Language = strings.ToLower(in.Language)
+	if err := validateDestinationFields(in.Name, in.Country, in.CountryCode, in.Currency, in.Language, in.AverageCostPerDay); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateDestinationPoint(in.Coordinates); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkCountry(in.CountryCode); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.DestinationRepo.UpdateDestination(in)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if errors.Is(err, postgres.ErrDuplicateDestination) {
+		return nil, status.Error(codes.AlreadyExists, "a destination with this name and country already exists")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini yangilashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	s.invalidateTrending(ctx)
+
+	return resp, nil
+}
+
+func (s *DestinationService) DeleteDestination(ctx context.Context, in *pb.DeleteDestinationRequest) (*pb.DeleteDestinationResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	err := s.DestinationRepo.DeleteDestination(in.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	s.invalidateTrending(ctx)
+
+	return &pb.DeleteDestinationResponse{Message: "Destination deleted successfully"}, nil
+}
+
+func (s *DestinationService) AddDestinationActivity(ctx context.Context, in *pb.AddDestinationItemRequest) (*pb.AddDestinationItemResponse, error) {
+	return s.addDestinationItem(ctx, in, s.DestinationRepo.AddDestinationActivity)
+}
+
+func (s *DestinationService) DeleteDestinationActivity(ctx context.Context, in *pb.DeleteDestinationItemRequest) (*pb.DeleteDestinationItemResponse, error) {
+	return s.deleteDestinationItem(ctx, in, s.DestinationRepo.DeleteDestinationActivity)
+}
+
+func (s *DestinationService) ListDestinationActivities(ctx context.Context, in *pb.ListDestinationItemsRequest) (*pb.ListDestinationItemsResponse, error) {
+	items, err := s.DestinationRepo.ListDestinationActivities(in.DestinationId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilidagi faoliyatlarni olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ListDestinationItemsResponse{Items: items}, nil
+}
+
+func (s *DestinationService) AddTopAttraction(ctx context.Context, in *pb.AddDestinationItemRequest) (*pb.AddDestinationItemResponse, error) {
+	return s.addDestinationItem(ctx, in, s.DestinationRepo.AddTopAttraction)
+}
+
+func (s *DestinationService) DeleteTopAttraction(ctx context.Context, in *pb.DeleteDestinationItemRequest) (*pb.DeleteDestinationItemResponse, error) {
+	return s.deleteDestinationItem(ctx, in, s.DestinationRepo.DeleteTopAttraction)
+}
+
+func (s *DestinationService) ListTopAttractions(ctx context.Context, in *pb.ListDestinationItemsRequest) (*pb.ListDestinationItemsResponse, error) {
+	items, err := s.DestinationRepo.ListTopAttractions(in.DestinationId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilidagi diqqatga sazovor joylarni olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ListDestinationItemsResponse{Items: items}, nil
+}
+
+func (s *DestinationService) addDestinationItem(ctx context.Context, in *pb.AddDestinationItemRequest, add func(destinationId, text string) (*pb.DestinationItem, error)) (*pb.AddDestinationItemResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimSpace(in.Text)
+	if text == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is required")
+	}
+
+	item, err := add(in.DestinationId, text)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manziliga yozuv qo'shishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.AddDestinationItemResponse{Item: item}, nil
+}
+
+func (s *DestinationService) deleteDestinationItem(ctx context.Context, in *pb.DeleteDestinationItemRequest, remove func(id string) error) (*pb.DeleteDestinationItemResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	err := remove(in.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili yozuvini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.DeleteDestinationItemResponse{Message: "Item deleted successfully"}, nil
+}
+
+// checkCountry makes sure a country code is known, an empty code is fine.
+func (s *DestinationService) checkCountry(code string) error {
+	if code == "" {
+		return nil
+	}
+
+	_, err := s.DestinationRepo.GetCountryName(code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.InvalidArgument, "unknown country code %q", code)
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik davlat kodini tekshirishda", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func validateDestination(name, country, countryCode, cur, language string, costPerDay float32) error {
+	if strings.TrimSpace(name) == "" || strings.TrimSpace(country) == "" {
+		return errors.New("name and country are required")
+	}
+
+	return validateDestinationFields(name, country, countryCode, cur, language, costPerDay)
+}
+
+// validateDestinationFields checks the fields that are given, an update
+// leaves out the ones it keeps.
+func validateDestinationFields(name, country, countryCode, cur, language string, costPerDay float32) error {
+	if len([]rune(name)) > 100 || len([]rune(country)) > 100 {
+		return errors.New("name and country must be at most 100 characters")
+	}
+	if countryCode != "" && !isCountryCode(countryCode) {
+		return errors.New("country_code must be an ISO 3166-1 alpha-2 code")
+	}
+	if cur != "" {
+		if err := currency.Validate(cur); err != nil {
+			return err
+		}
+	}
+	if language != "" && !isLanguageCode(language) {
+		return errors.New("language must be an ISO 639-1 code, e.g. en")
+	}
+	if costPerDay < 0 {
+		return errors.New("average_cost_per_day must not be negative")
+	}
+
+	return nil
+}
+
+// isLanguageCode checks that s looks like an ISO 639-1 code, e.g. pt.
+func isLanguageCode(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+
+	return true
+}
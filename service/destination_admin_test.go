@@ -0,0 +1,23 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDestination(t *testing.T) {
+	assert.NoError(t, validateDestination("Lisbon", "Portugal", "PT", "EUR", "pt", 90))
+	assert.NoError(t, validateDestination("Lisbon", "Portugal", "", "", "", 0))
+
+	assert.Error(t, validateDestination("", "Portugal", "PT", "EUR", "pt", 90))
+	assert.Error(t, validateDestination("Lisbon", " ", "PT", "EUR", "pt", 90))
+	assert.Error(t, validateDestination("Lisbon", "Portugal", "pt", "EUR", "pt", 90))
+	assert.Error(t, validateDestination("Lisbon", "Portugal", "PT", "EURO", "pt", 90))
+	assert.Error(t, validateDestination("Lisbon", "Portugal", "PT", "EUR", "Portuguese", 90))
+	assert.Error(t, validateDestination("Lisbon", "Portugal", "PT", "EUR", "pt", -1))
+
+	// An update may leave out any field.
+	assert.NoError(t, validateDestinationFields("", "", "", "", "", 0))
+	assert.Error(t, validateDestinationFields("", "", "", "", "Portuguese", 0))
+}
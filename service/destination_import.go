@@ -0,0 +1,628 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	formatCSV   = "csv"
+	formatJSONL = "jsonl"
+
+	// itemSeparator joins activities and top attractions in a CSV cell.
+	itemSeparator = "|"
+	// maxImportErrors caps the row errors sent back, the count of failed
+	// rows is always complete.
+	maxImportErrors = 100
+	maxImportLine   = 1 << 20
+)
+
+var errInvalidImport = errors.New("invalid import file")
+
+// destinationColumns is the CSV header of an export. An import needs the
+// name and country columns, the others may be left out or reordered.
+var destinationColumns = []string{
+	"name",
+	"country",
+	"country_code",
+	"description",
+	"best_time_to_visit",
+	"average_cost_per_day",
+	"currency",
+	"language",
+	"latitude",
+	"longitude",
+	"activities",
+	"top_attractions",
+	"kind",
+	"parent_name",
+	"parent_country",
+}
+
+// destinationRow is one line of a JSON Lines file.
+type destinationRow struct {
+	Name              string   `json:"name"`
+	Country           string   `json:"country"`
+	CountryCode       string   `json:"country_code,omitempty"`
+	Description       string   `json:"description,omitempty"`
+	BestTimeToVisit   string   `json:"best_time_to_visit,omitempty"`
+	AverageCostPerDay float32  `json:"average_cost_per_day,omitempty"`
+	Currency          string   `json:"currency,omitempty"`
+	Language          string   `json:"language,omitempty"`
+	Coordinates       *geoRow  `json:"coordinates,omitempty"`
+	Activities        []string `json:"activities,omitempty"`
+	TopAttractions    []string `json:"top_attractions,omitempty"`
+	Kind              string   `json:"kind,omitempty"`
+	ParentName        string   `json:"parent_name,omitempty"`
+	ParentCountry     string   `json:"parent_country,omitempty"`
+}
+
+type geoRow struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ImportDestinations reads a CSV or JSON Lines file sent in chunks. The first
+// message names the admin and the format. Rows that fail are reported and
+// skipped, the rest are saved.
+func (s *DestinationService) ImportDestinations(stream pb.TravelDestinationService_ImportDestinationsServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "import is empty")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.requireAdmin(first.UserId); err != nil {
+		return err
+	}
+
+	format := strings.ToLower(first.Format)
+	if !isExportFormat(format) {
+		return status.Error(codes.InvalidArgument, "format must be csv or jsonl")
+	}
+
+	resp, err := s.ImportDestinationsFrom(&importReader{stream: stream, data: first.Data}, format)
+	if errors.Is(err, errInvalidImport) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// ImportDestinationsFrom upserts the destinations of a CSV or JSON Lines
+// file. Malformed lines are reported like rows that fail validation, so only
+// a broken CSV header rejects the file, and that before anything is saved.
+func (s *DestinationService) ImportDestinationsFrom(r io.Reader, format string) (*pb.ImportDestinationsResponse, error) {
+	countries := map[string]bool{}
+	defer s.invalidateTrending(context.Background())
+
+	return importDestinations(r, format, func(d *pb.AddDestinationRequest) (bool, error) {
+		if d.CountryCode != "" && !countries[d.CountryCode] {
+			_, err := s.DestinationRepo.GetCountryName(d.CountryCode)
+			if errors.Is(err, sql.ErrNoRows) {
+				return false, fmt.Errorf("unknown country code %q", d.CountryCode)
+			}
+			if err != nil {
+				s.Logger.Error("Xatolik davlat kodini tekshirishda", slog.String("error", err.Error()))
+				return false, errors.New("country code could not be checked")
+			}
+			countries[d.CountryCode] = true
+		}
+		if err := s.placeImportedDestination(d); err != nil {
+			return false, err
+		}
+
+		created, err := s.DestinationRepo.UpsertDestination(d)
+		if err != nil {
+			s.Logger.Error("Xatolik import qilingan sayohat manzilini saqlashda", slog.String("error", err.Error()))
+			return false, errors.New("destination could not be saved")
+		}
+
+		return created, nil
+	})
+}
+
+// placeImportedDestination checks the kind and parent of an imported row as
+// AddDestination and UpdateDestination do. A row without them keeps those of
+// the destination it updates; the parent country defaults to the row's.
+func (s *DestinationService) placeImportedDestination(d *pb.AddDestinationRequest) error {
+	id, err := s.DestinationRepo.FindDestination(d.Name, d.Country)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		s.Logger.Error("Xatolik import qilingan sayohat manzilini olishda", slog.String("error", err.Error()))
+		return errors.New("destination could not be checked")
+	}
+
+	kind, parentId := models.KindCity, ""
+	if id != "" {
+		kind, parentId, _, err = s.DestinationRepo.GetDestinationPlace(id)
+		if err != nil {
+			s.Logger.Error("Xatolik import qilingan sayohat manzilini olishda", slog.String("error", err.Error()))
+			return errors.New("destination could not be checked")
+		}
+	}
+	if d.Kind != "" {
+		if d.Kind, err = normalizeDestinationKind(d.Kind); err != nil {
+			return err
+		}
+		kind = d.Kind
+	}
+
+	if d.ParentName != "" && d.ParentCountry == "" {
+		d.ParentCountry = d.Country
+	}
+	if err = s.resolveParentName(d); err != nil {
+		return importError(err)
+	}
+	if d.ParentId != "" {
+		parentId = d.ParentId
+	}
+
+	_, err = s.placeDestination(id, kind, parentId)
+	return importError(err)
+}
+
+// importError turns an error of a service check into a row error, keeping
+// only the message of a gRPC status.
+func importError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok {
+		return errors.New(st.Message())
+	}
+
+	return errors.New("destination could not be checked")
+}
+
+// ExportDestinations streams every destination as a CSV or JSON Lines file
+// that ImportDestinations accepts back, one row per message.
+func (s *DestinationService) ExportDestinations(in *pb.ExportDestinationsRequest, stream pb.TravelDestinationService_ExportDestinationsServer) error {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return err
+	}
+
+	format := strings.ToLower(in.Format)
+	if !isExportFormat(format) {
+		return status.Error(codes.InvalidArgument, "format must be csv or jsonl")
+	}
+
+	err := writeDestinations(&exportWriter{stream: stream}, format, s.DestinationRepo.ExportDestinations)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzillarini eksport qilishda", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func importDestinations(r io.Reader, format string, save func(*pb.AddDestinationRequest) (bool, error)) (*pb.ImportDestinationsResponse, error) {
+	var resp pb.ImportDestinationsResponse
+
+	fail := func(line int, name string, err error) {
+		resp.Failed++
+		if len(resp.Errors) < maxImportErrors {
+			resp.Errors = append(resp.Errors, &pb.ImportRowError{Line: int32(line), Name: name, Message: err.Error()})
+		}
+	}
+
+	err := readDestinations(r, format, func(line int, d *pb.AddDestinationRequest, err error) {
+		if err == nil {
+			err = validateImportedDestination(d)
+		}
+		if err != nil {
+			fail(line, d.GetName(), err)
+			return
+		}
+
+		created, err := save(d)
+		switch {
+		case err != nil:
+			fail(line, d.Name, err)
+		case created:
+			resp.Created++
+		default:
+			resp.Updated++
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func validateImportedDestination(d *pb.AddDestinationRequest) error {
+	if err := validateDestination(d.Name, d.Country, d.CountryCode, d.Currency, d.Language, d.AverageCostPerDay); err != nil {
+		return err
+	}
+	if err := validateDestinationPoint(d.Coordinates); err != nil {
+		return err
+	}
+	for _, items := range [][]string{d.Activities, d.TopAttractions} {
+		for _, text := range items {
+			if text == "" {
+				return errors.New("activities and top attractions must not be empty")
+			}
+		}
+	}
+
+	return nil
+}
+
+// readDestinations calls fn with every row of the file and its line number.
+// Rows that cannot be read are passed on with their error, a file that cannot
+// be read at all gives an error wrapping errInvalidImport before any row.
+func readDestinations(r io.Reader, format string, fn func(line int, d *pb.AddDestinationRequest, err error)) error {
+	switch format {
+	case formatCSV:
+		return readDestinationsCSV(r, fn)
+	case formatJSONL:
+		return readDestinationsJSONL(r, fn)
+	}
+
+	return fmt.Errorf("%w: unknown format %q", errInvalidImport, format)
+}
+
+func readDestinationsCSV(r io.Reader, fn func(line int, d *pb.AddDestinationRequest, err error)) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return csvError(err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isDestinationColumn(name) {
+			return fmt.Errorf("%w: unknown column %q", errInvalidImport, name)
+		}
+		if _, ok := columns[name]; ok {
+			return fmt.Errorf("%w: duplicate column %q", errInvalidImport, name)
+		}
+		columns[name] = i
+	}
+	for _, name := range []string{"name", "country"} {
+		if _, ok := columns[name]; !ok {
+			return fmt.Errorf("%w: missing column %q", errInvalidImport, name)
+		}
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		// The reader carries on with the next record after a parse error.
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && !errors.Is(err, csv.ErrFieldCount) {
+			fn(parseErr.StartLine, nil, fmt.Errorf("invalid CSV: %v", parseErr.Err))
+			continue
+		}
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			return err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			fn(line, nil, errors.New("wrong number of fields"))
+			continue
+		}
+
+		d, err := destinationFromRecord(record, columns)
+		fn(line, d, err)
+	}
+}
+
+func csvError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return fmt.Errorf("%w: %v", errInvalidImport, err)
+	}
+
+	return err
+}
+
+func destinationFromRecord(record []string, columns map[string]int) (*pb.AddDestinationRequest, error) {
+	cell := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	d := destinationRow{
+		Name:            cell("name"),
+		Country:         cell("country"),
+		CountryCode:     cell("country_code"),
+		Description:     cell("description"),
+		BestTimeToVisit: cell("best_time_to_visit"),
+		Currency:        cell("currency"),
+		Language:        cell("language"),
+		Activities:      splitItems(cell("activities")),
+		TopAttractions:  splitItems(cell("top_attractions")),
+		Kind:            cell("kind"),
+		ParentName:      cell("parent_name"),
+		ParentCountry:   cell("parent_country"),
+	}
+
+	if cost := cell("average_cost_per_day"); cost != "" {
+		value, err := strconv.ParseFloat(cost, 32)
+		if err != nil {
+			return d.toPb(), fmt.Errorf("invalid average_cost_per_day %q", cost)
+		}
+		d.AverageCostPerDay = float32(value)
+	}
+
+	lat, lng := cell("latitude"), cell("longitude")
+	if lat != "" || lng != "" {
+		var err error
+		d.Coordinates = &geoRow{}
+		if d.Coordinates.Latitude, err = strconv.ParseFloat(lat, 64); err != nil {
+			return d.toPb(), fmt.Errorf("invalid latitude %q", lat)
+		}
+		if d.Coordinates.Longitude, err = strconv.ParseFloat(lng, 64); err != nil {
+			return d.toPb(), fmt.Errorf("invalid longitude %q", lng)
+		}
+	}
+
+	return d.toPb(), nil
+}
+
+func readDestinationsJSONL(r io.Reader, fn func(line int, d *pb.AddDestinationRequest, err error)) error {
+	reader := bufio.NewReaderSize(r, 64*1024)
+
+	for line := 1; ; line++ {
+		text, tooLong, err := readImportLine(reader)
+		if err == io.EOF && len(text) == 0 && !tooLong {
+			return nil
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		text = bytes.TrimSpace(text)
+		switch {
+		case tooLong:
+			fn(line, nil, fmt.Errorf("line is longer than %d bytes", maxImportLine))
+		case len(text) > 0:
+			d, err := destinationFromJSON(text)
+			fn(line, d, err)
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// readImportLine reads the next line with its line ending. A line longer than
+// maxImportLine is read to its end without being kept and reported as too
+// long, so the lines after it can still be imported.
+func readImportLine(r *bufio.Reader) ([]byte, bool, error) {
+	var line []byte
+	tooLong := false
+	for {
+		chunk, err := r.ReadSlice('\n')
+		if !tooLong {
+			line = append(line, chunk...)
+			if len(bytes.TrimRight(line, "\r\n")) > maxImportLine {
+				line, tooLong = nil, true
+			}
+		}
+		if err != bufio.ErrBufferFull {
+			return line, tooLong, err
+		}
+	}
+}
+
+func destinationFromJSON(text []byte) (*pb.AddDestinationRequest, error) {
+	var d destinationRow
+	decoder := json.NewDecoder(bytes.NewReader(text))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&d); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+
+	return d.toPb(), nil
+}
+
+// writeDestinations writes a file in the given format from the destinations
+// export passes on.
+func writeDestinations(w io.Writer, format string, export func(func(*pb.AddDestinationRequest) error) error) error {
+	switch format {
+	case formatCSV:
+		writer := csv.NewWriter(w)
+		writer.Write(destinationColumns)
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return err
+		}
+
+		return export(func(d *pb.AddDestinationRequest) error {
+			writer.Write(destinationRecord(d))
+			writer.Flush()
+			return writer.Error()
+		})
+	case formatJSONL:
+		encoder := json.NewEncoder(w)
+
+		return export(func(d *pb.AddDestinationRequest) error {
+			return encoder.Encode(rowFromPb(d))
+		})
+	}
+
+	return fmt.Errorf("unknown format %q", format)
+}
+
+func destinationRecord(d *pb.AddDestinationRequest) []string {
+	var lat, lng string
+	if d.Coordinates != nil {
+		lat = formatCoordinate(d.Coordinates.Latitude)
+		lng = formatCoordinate(d.Coordinates.Longitude)
+	}
+
+	return []string{
+		d.Name,
+		d.Country,
+		d.CountryCode,
+		d.Description,
+		d.BestTimeToVisit,
+		strconv.FormatFloat(float64(d.AverageCostPerDay), 'f', -1, 32),
+		d.Currency,
+		d.Language,
+		lat,
+		lng,
+		strings.Join(d.Activities, itemSeparator),
+		strings.Join(d.TopAttractions, itemSeparator),
+		d.Kind,
+		d.ParentName,
+		d.ParentCountry,
+	}
+}
+
+func (d destinationRow) toPb() *pb.AddDestinationRequest {
+	var coordinates *pb.GeoPoint
+	if d.Coordinates != nil {
+		coordinates = &pb.GeoPoint{Latitude: d.Coordinates.Latitude, Longitude: d.Coordinates.Longitude}
+	}
+
+	return &pb.AddDestinationRequest{
+		Name:              strings.TrimSpace(d.Name),
+		Country:           strings.TrimSpace(d.Country),
+		CountryCode:       strings.ToUpper(strings.TrimSpace(d.CountryCode)),
+		Description:       strings.TrimSpace(d.Description),
+		BestTimeToVisit:   strings.TrimSpace(d.BestTimeToVisit),
+		AverageCostPerDay: d.AverageCostPerDay,
+		Currency:          strings.ToUpper(strings.TrimSpace(d.Currency)),
+		Language:          strings.ToLower(strings.TrimSpace(d.Language)),
+		Coordinates:       coordinates,
+		Activities:        trimItems(d.Activities),
+		TopAttractions:    trimItems(d.TopAttractions),
+		Kind:              strings.ToLower(strings.TrimSpace(d.Kind)),
+		ParentName:        strings.TrimSpace(d.ParentName),
+		ParentCountry:     strings.TrimSpace(d.ParentCountry),
+	}
+}
+
+func rowFromPb(d *pb.AddDestinationRequest) destinationRow {
+	var coordinates *geoRow
+	if d.Coordinates != nil {
+		coordinates = &geoRow{Latitude: d.Coordinates.Latitude, Longitude: d.Coordinates.Longitude}
+	}
+
+	return destinationRow{
+		Name:              d.Name,
+		Country:           d.Country,
+		CountryCode:       d.CountryCode,
+		Description:       d.Description,
+		BestTimeToVisit:   d.BestTimeToVisit,
+		AverageCostPerDay: d.AverageCostPerDay,
+		Currency:          d.Currency,
+		Language:          d.Language,
+		Coordinates:       coordinates,
+		Activities:        d.Activities,
+		TopAttractions:    d.TopAttractions,
+		Kind:              d.Kind,
+		ParentName:        d.ParentName,
+		ParentCountry:     d.ParentCountry,
+	}
+}
+
+func splitItems(cell string) []string {
+	if cell == "" {
+		return nil
+	}
+
+	var items []string
+	for _, item := range strings.Split(cell, itemSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func trimItems(items []string) []string {
+	for i := range items {
+		items[i] = strings.TrimSpace(items[i])
+	}
+
+	return items
+}
+
+func isDestinationColumn(name string) bool {
+	for _, column := range destinationColumns {
+		if column == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isExportFormat(format string) bool {
+	return format == formatCSV || format == formatJSONL
+}
+
+// importReader reads the file data of an import stream message by message.
+type importReader struct {
+	stream pb.TravelDestinationService_ImportDestinationsServer
+	data   []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.data = msg.Data
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
+
+// exportWriter sends every write as one message of an export stream.
+type exportWriter struct {
+	stream pb.TravelDestinationService_ExportDestinationsServer
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	if err := w.stream.Send(&pb.ExportDestinationsResponse{Data: data}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"bytes"
+	pb "content-service/generated/destination"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collectImport(t *testing.T, content, format string) (*pb.ImportDestinationsResponse, []*pb.AddDestinationRequest) {
+	var saved []*pb.AddDestinationRequest
+	resp, err := importDestinations(strings.NewReader(content), format, func(d *pb.AddDestinationRequest) (bool, error) {
+		if d.Name == "Broken" {
+			return false, errors.New("destination could not be saved")
+		}
+		saved = append(saved, d)
+		return d.Name != "Porto", nil
+	})
+	assert.NoError(t, err)
+
+	return resp, saved
+}
+
+func TestImportDestinationsCSV(t *testing.T) {
+	content := "Country,name,currency,language,average_cost_per_day,activities\n" +
+		"Portugal,Lisbon,eur,PT,85.5,Surfing | Tram ride\n" +
+		"Portugal,Porto,EUR,pt,70,\n" +
+		"Portugal,Faro,EUR,Portuguese,60,\n" +
+		"Portugal,Broken,EUR,pt,60,\n" +
+		"Portugal,Braga\n" +
+		"Portugal,Coimbra,EUR,pt,cheap,\n"
+
+	resp, saved := collectImport(t, content, formatCSV)
+
+	assert.Equal(t, int32(1), resp.Created)
+	assert.Equal(t, int32(1), resp.Updated)
+	assert.Equal(t, int32(4), resp.Failed)
+	assert.Len(t, saved, 2)
+
+	assert.Equal(t, "EUR", saved[0].Currency)
+	assert.Equal(t, "pt", saved[0].Language)
+	assert.Equal(t, float32(85.5), saved[0].AverageCostPerDay)
+	assert.Equal(t, []string{"Surfing", "Tram ride"}, saved[0].Activities)
+
+	lines := []int32{}
+	for _, e := range resp.Errors {
+		lines = append(lines, e.Line)
+	}
+	assert.Equal(t, []int32{4, 5, 6, 7}, lines)
+	assert.Equal(t, "Faro", resp.Errors[0].Name)
+}
+
+func TestImportDestinationsCSVHeader(t *testing.T) {
+	_, err := importDestinations(strings.NewReader("name,city\n"), formatCSV, nil)
+	assert.ErrorIs(t, err, errInvalidImport)
+
+	_, err = importDestinations(strings.NewReader("name,currency\n"), formatCSV, nil)
+	assert.ErrorIs(t, err, errInvalidImport)
+}
+
+func TestImportDestinationsMalformedLines(t *testing.T) {
+	csvContent := "name,country\n" +
+		"Lisbon,Portugal\n" +
+		"Po\"rto,Portugal\n" +
+		"Faro,Portugal\n"
+
+	resp, saved := collectImport(t, csvContent, formatCSV)
+	assert.Equal(t, int32(2), resp.Created)
+	assert.Equal(t, int32(1), resp.Failed)
+	assert.Equal(t, int32(3), resp.Errors[0].Line)
+	assert.Equal(t, "Faro", saved[1].Name)
+
+	jsonlContent := `{"name": "Lisbon", "country": "Portugal"}` + "\n" +
+		`{"name": "` + strings.Repeat("x", maxImportLine) + `", "country": "Portugal"}` + "\n" +
+		`{"name": "Faro", "country": "Portugal"}`
+
+	resp, saved = collectImport(t, jsonlContent, formatJSONL)
+	assert.Equal(t, int32(2), resp.Created)
+	assert.Equal(t, int32(1), resp.Failed)
+	assert.Equal(t, int32(2), resp.Errors[0].Line)
+	assert.Equal(t, "Faro", saved[1].Name)
+}
+
+func TestImportDestinationsJSONL(t *testing.T) {
+	content := `{"name": "Lisbon", "country": "Portugal", "country_code": "pt", "top_attractions": ["Belem Tower"]}` + "\n" +
+		"\n" +
+		`{"name": "Porto", "country": "Portugal", "rating": 5}` + "\n" +
+		`{"name": "Porto", "country": ` + "\n"
+
+	resp, saved := collectImport(t, content, formatJSONL)
+
+	assert.Equal(t, int32(1), resp.Created)
+	assert.Equal(t, int32(2), resp.Failed)
+	assert.Equal(t, "PT", saved[0].CountryCode)
+	assert.Equal(t, []string{"Belem Tower"}, saved[0].TopAttractions)
+	assert.Equal(t, int32(3), resp.Errors[0].Line)
+	assert.Equal(t, int32(4), resp.Errors[1].Line)
+}
+
+func TestExportDestinationsRoundTrip(t *testing.T) {
+	destinations := []*pb.AddDestinationRequest{
+		{Name: "Lisbon", Country: "Portugal", CountryCode: "PT", Description: "Hills, trams and \"pastéis\"", AverageCostPerDay: 85.5, Currency: "EUR", Language: "pt", Coordinates: &pb.GeoPoint{Latitude: 38.7223, Longitude: -9.1393}, Activities: []string{"Surfing", "Tram ride"}, Kind: "city", ParentName: "Lisbon District", ParentCountry: "Portugal"},
+		{Name: "Kyoto", Country: "Japan", TopAttractions: []string{"Fushimi Inari"}},
+	}
+	export := func(fn func(*pb.AddDestinationRequest) error) error {
+		for _, d := range destinations {
+			if err := fn(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, format := range []string{formatCSV, formatJSONL} {
+		var buf bytes.Buffer
+		assert.NoError(t, writeDestinations(&buf, format, export))
+
+		resp, saved := collectImport(t, buf.String(), format)
+		assert.Equal(t, int32(2), resp.Created, format)
+		assert.Empty(t, resp.Errors, format)
+		assert.Equal(t, destinations[0].Description, saved[0].Description, format)
+		assert.Equal(t, destinations[0].Activities, saved[0].Activities, format)
+		assert.Equal(t, "city", saved[0].Kind, format)
+		assert.Equal(t, "Lisbon District", saved[0].ParentName, format)
+		assert.Equal(t, "Portugal", saved[0].ParentCountry, format)
+		assert.Empty(t, saved[1].ParentName, format)
+		assert.Equal(t, destinations[0].Coordinates.Latitude, saved[0].Coordinates.Latitude, format)
+		assert.Nil(t, saved[1].Coordinates, format)
+		assert.Equal(t, destinations[1].TopAttractions, saved[1].TopAttractions, format)
+	}
+}
+
+func TestImportDestinationCoordinates(t *testing.T) {
+	content := "name,country,latitude,longitude\n" +
+		"Lisbon,Portugal,38.7223,-9.1393\n" +
+		"Porto,Portugal,41.1579,\n" +
+		"Faro,Portugal,97,-7.93\n"
+
+	resp, saved := collectImport(t, content, formatCSV)
+
+	assert.Equal(t, int32(2), resp.Failed)
+	assert.Equal(t, -9.1393, saved[0].Coordinates.Longitude)
+	assert.Equal(t, "Porto", resp.Errors[0].Name)
+	assert.Equal(t, "Faro", resp.Errors[1].Name)
+}
@@ -1,99 +1,166 @@
 package service
 
 import (
+	"content-service/currency"
 	pb "content-service/generated/destination"
 	"content-service/generated/user"
+	"content-service/models"
 	"content-service/storage/postgres"
 	rdb "content-service/storage/redis"
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
-	"time"
+	"strconv"
+	"strings"
 
-	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type DestinationService struct {
 	pb.UnimplementedTravelDestinationServiceServer
 	DestinationRepo *postgres.DestinationRepo
+	RateRepo        *postgres.ExchangeRateRepo
 	UserClient      *user.AuthServiceClient
 	RedisClient     *rdb.RedisClient
+	AdminIds        []string
 	Logger          *slog.Logger
+
+	trending singleflight.Group
 }
 
 func (s *DestinationService) ListTravelDestnations(ctx context.Context, in *pb.ListDetinationRequest) (*pb.ListDetinationResponse, error) {
+	if err := validateDestinationSearch(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rates, err := loadRates(s.RateRepo, s.Logger, in.TargetCurrency)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := s.DestinationRepo.GetDestinations(in)
 	if err != nil {
 		s.Logger.Error("sayohat manzillarni ro'yxatini olish")
 		return nil, err
 	}
 
+	locales := requestLocales(ctx)
+	ids := make([]string, len(resp.Destinations))
+	for i, v := range resp.Destinations {
+		ids[i] = v.Id
+	}
+	translations, err := s.destinationTranslations(ids, locales)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, v := range resp.Destinations {
-		activities, err := s.DestinationRepo.GetDestinationActivities(v.Id)
+		activities, err := s.DestinationRepo.GetDestinationActivities(v.Id, locales)
 		if err != nil {
 			s.Logger.Error("Xatolik sayohat manzilinig activitylarini olishda", slog.String("error", err.Error()))
 			return nil, err
 		}
 
+		translate(translations[v.Id], &v.Name, &v.Description)
 		v.PopularActivities = activities
+		v.ConvertedCostPerDay = convertCost(rates, float64(v.AverageCostPerDay), v.Currency, in.TargetCurrency)
 	}
 
 	return resp, nil
 }
 
 func (s *DestinationService) GetTravelDestination(ctx context.Context, in *pb.GetDestinationRequest) (*pb.GetDestinationResponse, error) {
+	rates, err := loadRates(s.RateRepo, s.Logger, in.TargetCurrency)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := s.DestinationRepo.GetTravelDestination(in.Id)
 	if err != nil {
 		s.Logger.Error("Sayohat manzili haqaida malumot olishda xatolik", slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	activities, err := s.DestinationRepo.GetDestinationActivities(resp.Id)
+	breadcrumbs, err := s.DestinationRepo.ListBreadcrumbs(resp.Id)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili joylashuvini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	locales := requestLocales(ctx)
+	ids := []string{resp.Id}
+	for _, crumb := range breadcrumbs {
+		ids = append(ids, crumb.Id)
+	}
+	translations, err := s.destinationTranslations(ids, locales)
+	if err != nil {
+		return nil, err
+	}
+	translate(translations[resp.Id], &resp.Name, &resp.Description)
+	for _, crumb := range breadcrumbs {
+		var description string
+		translate(translations[crumb.Id], &crumb.Name, &description)
+	}
+	resp.Breadcrumbs = breadcrumbs
+
+	activities, err := s.DestinationRepo.GetDestinationActivities(resp.Id, locales)
 	if err != nil {
 		s.Logger.Error("Sayohat manzilining activitysini olishda xatolik", slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	atractions, err := s.DestinationRepo.GetDestinationAttractions(resp.Id)
+	atractions, err := s.DestinationRepo.GetDestinationAttractions(resp.Id, locales)
 	if err != nil {
 		s.Logger.Error("Sayohat manzilining atractions olishda xatolik", slog.String("error", err.Error()))
 		return nil, err
 	}
 
+	seasons, err := s.DestinationRepo.ListDestinationSeasons(resp.Id)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili mavsumlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
 	resp.PopularActivities = activities
 	resp.TopAttractions = atractions
+	resp.Seasons = seasons
+
+	if cost, err := strconv.ParseFloat(resp.AverageCostPerDay, 64); err == nil {
+		resp.ConvertedCostPerDay = convertCost(rates, cost, resp.Currency, in.TargetCurrency)
+	}
 
 	return resp, nil
 }
 
-func (s *DestinationService) GetTrendDestinations(ctx context.Context, in *pb.GetTrendDestinationRequest) (*pb.GetTrendDestinationResponse, error) {
-	const cacheKey = "trending_destinations"
-	// Redis cachedan olishga harakat qilamiz
-	destinationsJSON, err := s.RedisClient.R.Get(ctx, cacheKey).Bytes()
-	if err == redis.Nil {
-		// Agar cacheda bo'lmasa, DBdan olamiz
-		destinations, err := s.DestinationRepo.GetTrendingDestinations(int(in.Limit))
-		if err != nil {
-			s.Logger.Error("xatolik top sayohat manzillarini olishda", slog.String("error", err.Error()))
-			return nil, err
-		}
-
-		// Cachega qo'shamiz
-		destinationsJSON, _ = json.Marshal(destinations)
-		s.RedisClient.R.Set(ctx, cacheKey, destinationsJSON, 10*time.Minute)
+// validateDestinationSearch normalizes the filters of a catalog search and
+// checks them.
+func validateDestinationSearch(in *pb.ListDetinationRequest) error {
+	in.Query = strings.TrimSpace(in.Query)
+	in.Country = strings.TrimSpace(in.Country)
+	in.Language = strings.ToLower(in.Language)
+	in.Currency = strings.ToUpper(in.Currency)
 
-		return &pb.GetTrendDestinationResponse{
-			Destinations: destinations.Destinations,
-			Total:        destinations.Total,
-		}, nil
-	} else if err != nil {
-		s.Logger.Error("xatolik  top sayohat manzillarini olishda redisdan", slog.String("error", err.Error()))
-		return nil, err
+	if in.Page < 1 || in.Limit < 1 {
+		return errors.New("page and limit must be positive")
+	}
+	if in.Language != "" && !isLanguageCode(in.Language) {
+		return errors.New("language must be an ISO 639-1 code, e.g. en")
+	}
+	if in.Currency != "" {
+		if err := currency.Validate(in.Currency); err != nil {
+			return err
+		}
 	}
 
-	// Cachedan olingan ma'lumotlarni deserializatsiya qilamiz
-	var trendDestinations pb.GetTrendDestinationResponse
-	json.Unmarshal(destinationsJSON, &trendDestinations)
+	switch in.CostBand {
+	case "", models.CostBudget, models.CostModerate, models.CostExpensive, models.CostLuxury:
+	default:
+		return fmt.Errorf("cost_band must be one of %s, %s, %s or %s",
+			models.CostBudget, models.CostModerate, models.CostExpensive, models.CostLuxury)
+	}
 
-	return &trendDestinations, nil
+	return nil
 }
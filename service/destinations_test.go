@@ -0,0 +1,21 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDestinationSearch(t *testing.T) {
+	req := &pb.ListDetinationRequest{Query: " pari ", Page: 1, Limit: 10, Language: "FR", Currency: "eur", CostBand: "moderate"}
+	assert.NoError(t, validateDestinationSearch(req))
+	assert.Equal(t, "pari", req.Query)
+	assert.Equal(t, "fr", req.Language)
+	assert.Equal(t, "EUR", req.Currency)
+
+	assert.Error(t, validateDestinationSearch(&pb.ListDetinationRequest{Page: 0, Limit: 10}))
+	assert.Error(t, validateDestinationSearch(&pb.ListDetinationRequest{Page: 1, Limit: 10, Language: "French"}))
+	assert.Error(t, validateDestinationSearch(&pb.ListDetinationRequest{Page: 1, Limit: 10, Currency: "EURO"}))
+	assert.Error(t, validateDestinationSearch(&pb.ListDetinationRequest{Page: 1, Limit: 10, CostBand: "cheap"}))
+}
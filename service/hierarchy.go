@@ -0,0 +1,152 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultChildLimit = 20
+	maxChildLimit     = 100
+)
+
+// ListChildDestinations lists what lies under a destination, e.g. the cities
+// of a region.
+func (s *DestinationService) ListChildDestinations(ctx context.Context, in *pb.ListChildDestinationsRequest) (*pb.ListChildDestinationsResponse, error) {
+	if err := normalizeChildList(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkDestination(in.DestinationId); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.DestinationRepo.ListChildDestinations(in)
+	if err != nil {
+		s.Logger.Error("Xatolik ichki sayohat manzillarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	ids := make([]string, len(resp.Destinations))
+	for i, v := range resp.Destinations {
+		ids[i] = v.Id
+	}
+	translations, err := s.destinationTranslations(ids, requestLocales(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range resp.Destinations {
+		translate(translations[v.Id], &v.Name, &v.Description)
+	}
+
+	return resp, nil
+}
+
+// placeDestination checks where a destination of kind goes under parentId
+// and returns the country of the parent. id is empty for new destinations.
+func (s *DestinationService) placeDestination(id, kind, parentId string) (string, error) {
+	if id != "" {
+		kinds, err := s.DestinationRepo.ListChildKinds(id)
+		if err != nil {
+			s.Logger.Error("Xatolik ichki sayohat manzillarini olishda", slog.String("error", err.Error()))
+			return "", err
+		}
+		for _, child := range kinds {
+			if !isBroaderKind(kind, child) {
+				return "", status.Errorf(codes.FailedPrecondition, "a %s cannot have a %s under it", kind, child)
+			}
+		}
+	}
+
+	if parentId == "" {
+		return "", nil
+	}
+	if parentId == id {
+		return "", status.Error(codes.InvalidArgument, "a destination cannot be its own parent")
+	}
+
+	parentKind, _, country, err := s.DestinationRepo.GetDestinationPlace(parentId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", status.Error(codes.InvalidArgument, "parent destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik yuqori sayohat manzilini olishda", slog.String("error", err.Error()))
+		return "", err
+	}
+	if !isBroaderKind(parentKind, kind) {
+		return "", status.Errorf(codes.InvalidArgument, "a %s cannot be under a %s", kind, parentKind)
+	}
+
+	return country, nil
+}
+
+// resolveParentName sets the parent id of a destination that names its
+// parent by name and country instead.
+func (s *DestinationService) resolveParentName(d *pb.AddDestinationRequest) error {
+	if d.ParentId != "" || d.ParentName == "" {
+		return nil
+	}
+
+	id, err := s.DestinationRepo.FindDestination(d.ParentName, d.ParentCountry)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.InvalidArgument, "parent destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik yuqori sayohat manzilini olishda", slog.String("error", err.Error()))
+		return err
+	}
+	d.ParentId = id
+
+	return nil
+}
+
+// normalizeDestinationKind lowercases a kind, defaulting to city, and checks
+// it.
+func normalizeDestinationKind(kind string) (string, error) {
+	kind = strings.ToLower(kind)
+	if kind == "" {
+		return models.KindCity, nil
+	}
+	if !slices.Contains(models.DestinationKinds, kind) {
+		return "", errors.New("kind must be one of " + strings.Join(models.DestinationKinds, ", "))
+	}
+
+	return kind, nil
+}
+
+// isBroaderKind reports whether a destination of kind parent can hold one
+// of kind child. Requiring a strictly broader parent also rules out cycles.
+func isBroaderKind(parent, child string) bool {
+	p, c := slices.Index(models.DestinationKinds, parent), slices.Index(models.DestinationKinds, child)
+	return p >= 0 && c >= 0 && p < c
+}
+
+// normalizeChildList fills in the defaults of a child list request and
+// checks the rest of it.
+func normalizeChildList(in *pb.ListChildDestinationsRequest) error {
+	in.Kind = strings.ToLower(in.Kind)
+
+	if in.Kind != "" && !slices.Contains(models.DestinationKinds, in.Kind) {
+		return fmt.Errorf("kind must be one of %s", strings.Join(models.DestinationKinds, ", "))
+	}
+	if in.Page < 0 || in.Limit < 0 || in.Limit > maxChildLimit {
+		return errors.New("page must be positive and limit between 1 and 100")
+	}
+	if in.Page == 0 {
+		in.Page = 1
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultChildLimit
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeDestinationKind(t *testing.T) {
+	kind, err := normalizeDestinationKind("")
+	assert.NoError(t, err)
+	assert.Equal(t, models.KindCity, kind)
+
+	kind, err = normalizeDestinationKind("POI")
+	assert.NoError(t, err)
+	assert.Equal(t, models.KindPOI, kind)
+
+	_, err = normalizeDestinationKind("continent")
+	assert.Error(t, err)
+}
+
+func TestIsBroaderKind(t *testing.T) {
+	assert.True(t, isBroaderKind(models.KindCountry, models.KindRegion))
+	assert.True(t, isBroaderKind(models.KindCountry, models.KindCity))
+	assert.True(t, isBroaderKind(models.KindCity, models.KindPOI))
+
+	assert.False(t, isBroaderKind(models.KindCity, models.KindCity))
+	assert.False(t, isBroaderKind(models.KindPOI, models.KindCity))
+	assert.False(t, isBroaderKind(models.KindRegion, models.KindCountry))
+	assert.False(t, isBroaderKind("planet", models.KindCountry))
+}
+
+func TestNormalizeChildList(t *testing.T) {
+	in := &pb.ListChildDestinationsRequest{Kind: "City"}
+	assert.NoError(t, normalizeChildList(in))
+	assert.Equal(t, models.KindCity, in.Kind)
+	assert.Equal(t, int32(1), in.Page)
+	assert.Equal(t, int32(defaultChildLimit), in.Limit)
+
+	assert.Error(t, normalizeChildList(&pb.ListChildDestinationsRequest{Kind: "town"}))
+	assert.Error(t, normalizeChildList(&pb.ListChildDestinationsRequest{Limit: maxChildLimit + 1}))
+}
@@ -0,0 +1,657 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	icsProdID         = "-//Travel Tales//Content Service//EN"
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405"
+	icsUIDDomain      = "traveltales"
+)
+
+// renderItineraryICS turns a fully loaded itinerary into an RFC 5545
+// calendar. Every stay becomes an all-day event, activities that only have a
+// day become all-day events on that day and timed activities become events
+// in their own time zone, which is described by a VTIMEZONE component.
+func renderItineraryICS(it *pb.GetItineraryResponse, now time.Time) (string, error) {
+	w := &icsWriter{}
+	w.prop("BEGIN", "VCALENDAR")
+	w.prop("VERSION", "2.0")
+	w.prop("PRODID", icsProdID)
+	w.prop("CALSCALE", "GREGORIAN")
+	w.prop("METHOD", "PUBLISH")
+	w.prop("X-WR-CALNAME", icsEscape(it.Title))
+	if it.Description != "" {
+		w.prop("X-WR-CALDESC", icsEscape(it.Description))
+	}
+
+	type timedEvent struct {
+		act        *pb.Activity
+		stay       string
+		start, end time.Time
+	}
+	var events []timedEvent
+	zones := map[string]*time.Location{}
+	var first, last time.Time
+
+	for _, d := range it.Destinations {
+		for _, a := range d.Schedule {
+			if a.Day == "" || a.StartTime == "" {
+				continue
+			}
+			loc, err := loadZone(a.TimeZone)
+			if err != nil {
+				return "", err
+			}
+			start, end, err := activityInterval(activityFromPb(a), loc)
+			if err != nil {
+				return "", err
+			}
+			if loc != time.UTC {
+				zones[loc.String()] = loc
+			}
+			if first.IsZero() || start.Before(first) {
+				first = start
+			}
+			if latest := maxTime(start, end); latest.After(last) {
+				last = latest
+			}
+			events = append(events, timedEvent{act: a, stay: d.Name, start: start, end: end})
+		}
+	}
+
+	names := make([]string, 0, len(zones))
+	for name := range zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeVTimezone(w, zones[name], first, last)
+	}
+
+	stamp := now.UTC().Format(icsDateTimeLayout) + "Z"
+
+	for _, d := range it.Destinations {
+		start, err := parseDay(d.StartDate)
+		if err != nil {
+			return "", err
+		}
+		end, err := parseDay(d.EndDate)
+		if err != nil {
+			return "", err
+		}
+
+		var plain []string
+		for _, a := range d.Activities {
+			plain = append(plain, "- "+a)
+		}
+
+		w.prop("BEGIN", "VEVENT")
+		w.prop("UID", fmt.Sprintf("stay-%s@%s", d.Id, icsUIDDomain))
+		w.prop("DTSTAMP", stamp)
+		w.prop("DTSTART;VALUE=DATE", start.Format(icsDateLayout))
+		// DTEND of an all-day event is exclusive.
+		w.prop("DTEND;VALUE=DATE", end.AddDate(0, 0, 1).Format(icsDateLayout))
+		w.prop("SUMMARY", icsEscape(d.Name))
+		if len(plain) > 0 {
+			w.prop("DESCRIPTION", icsEscape(strings.Join(plain, "\n")))
+		}
+		w.prop("TRANSP", "TRANSPARENT")
+		w.prop("END", "VEVENT")
+
+		for _, a := range d.Schedule {
+			if a.Day == "" || a.StartTime != "" {
+				continue
+			}
+			day, err := parseDay(a.Day)
+			if err != nil {
+				return "", err
+			}
+			w.prop("BEGIN", "VEVENT")
+			w.prop("UID", fmt.Sprintf("activity-%s@%s", a.Id, icsUIDDomain))
+			w.prop("DTSTAMP", stamp)
+			w.prop("DTSTART;VALUE=DATE", day.Format(icsDateLayout))
+			w.prop("DTEND;VALUE=DATE", day.AddDate(0, 0, 1).Format(icsDateLayout))
+			writeActivityText(w, a, d.Name)
+			w.prop("END", "VEVENT")
+		}
+	}
+
+	for _, e := range events {
+		w.prop("BEGIN", "VEVENT")
+		w.prop("UID", fmt.Sprintf("activity-%s@%s", e.act.Id, icsUIDDomain))
+		w.prop("DTSTAMP", stamp)
+		w.prop(icsDateTimeProp("DTSTART", e.start))
+		if !e.end.IsZero() {
+			w.prop(icsDateTimeProp("DTEND", e.end))
+		}
+		writeActivityText(w, e.act, e.stay)
+		w.prop("END", "VEVENT")
+	}
+
+	w.prop("END", "VCALENDAR")
+
+	return w.String(), nil
+}
+
+func writeActivityText(w *icsWriter, a *pb.Activity, stay string) {
+	w.prop("SUMMARY", icsEscape(a.Title))
+	location := a.Location
+	if location == "" {
+		location = stay
+	}
+	if location != "" {
+		w.prop("LOCATION", icsEscape(location))
+	}
+	if a.Notes != "" {
+		w.prop("DESCRIPTION", icsEscape(a.Notes))
+	}
+}
+
+// icsDateTimeProp renders a DATE-TIME property in the zone of t: UTC times
+// use the "Z" form, everything else references a VTIMEZONE by its TZID.
+func icsDateTimeProp(name string, t time.Time) (string, string) {
+	if t.Location() == time.UTC {
+		return name, t.Format(icsDateTimeLayout) + "Z"
+	}
+
+	return fmt.Sprintf("%s;TZID=%s", name, t.Location().String()), t.Format(icsDateTimeLayout)
+}
+
+// writeVTimezone describes the UTC offsets loc uses between from and to.
+// Offsets are taken from the Go time zone database instead of recurrence
+// rules, so the component is exact for the dates the calendar covers.
+func writeVTimezone(w *icsWriter, loc *time.Location, from, to time.Time) {
+	from = from.In(loc).AddDate(0, 0, -1)
+	to = to.In(loc).AddDate(0, 0, 1)
+
+	w.prop("BEGIN", "VTIMEZONE")
+	w.prop("TZID", loc.String())
+
+	name, offset := from.Zone()
+	writeTzPeriod(w, from.IsDST(), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), offset, offset, name)
+
+	for t := from; t.Before(to); {
+		next := t.Add(24 * time.Hour)
+		_, before := t.Zone()
+		_, after := next.Zone()
+		if before != after {
+			// Narrow the change down to the minute it happens.
+			lo, hi := t, next
+			for hi.Sub(lo) > time.Minute {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if _, o := mid.Zone(); o == before {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			hi = hi.Truncate(time.Minute)
+			name, after := hi.Zone()
+			// DTSTART of an observance is the local time before the change.
+			local := hi.UTC().Add(time.Duration(before) * time.Second)
+			writeTzPeriod(w, hi.IsDST(), local, before, after, name)
+		}
+		t = next
+	}
+
+	w.prop("END", "VTIMEZONE")
+}
+
+func writeTzPeriod(w *icsWriter, dst bool, start time.Time, from, to int, name string) {
+	kind := "STANDARD"
+	if dst {
+		kind = "DAYLIGHT"
+	}
+	w.prop("BEGIN", kind)
+	w.prop("DTSTART", start.Format(icsDateTimeLayout))
+	w.prop("TZOFFSETFROM", icsOffset(from))
+	w.prop("TZOFFSETTO", icsOffset(to))
+	if name != "" {
+		w.prop("TZNAME", icsEscape(name))
+	}
+	w.prop("END", kind)
+}
+
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+type icsWriter struct {
+	b strings.Builder
+}
+
+// prop writes a content line, folding it at 75 octets without splitting
+// multi-byte characters.
+func (w *icsWriter) prop(name, value string) {
+	line := name + ":" + value
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			w.b.WriteString("\r\n ")
+			width = 1
+		}
+		w.b.WriteRune(r)
+		width += size
+	}
+	w.b.WriteString("\r\n")
+}
+
+func (w *icsWriter) String() string {
+	return w.b.String()
+}
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func icsEscape(s string) string {
+	return icsEscaper.Replace(s)
+}
+
+func icsUnescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+
+	return b.String()
+}
+
+// icsCalendar is the subset of an iCalendar document needed to rebuild an
+// itinerary.
+type icsCalendar struct {
+	Name        string
+	Description string
+	Events      []icsEvent
+	// Warnings notes events that were imported with a guess, such as a
+	// time zone this server does not know.
+	Warnings []string
+}
+
+type icsEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	AllDay      bool
+	// Start and End are in the zone the event was written in. For all-day
+	// events End is exclusive, as in the document.
+	Start time.Time
+	End   time.Time
+	// UnknownZone is a TZID of the event that could not be loaded; its
+	// times were read as floating times instead.
+	UnknownZone string
+}
+
+type icsLine struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICS reads the VEVENTs of an RFC 5545 document. Recurrence rules are
+// ignored, so a recurring event only contributes its first occurrence.
+func parseICS(content string) (*icsCalendar, error) {
+	lines := unfoldICS(content)
+	cal := &icsCalendar{}
+	defaultZone := time.UTC
+
+	var stack []string
+	var event *icsEvent
+	var hasEnd bool
+	var duration time.Duration
+
+	for n, raw := range lines {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		l, err := parseICSLine(raw)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+
+		switch l.name {
+		case "BEGIN":
+			stack = append(stack, strings.ToUpper(l.value))
+			if strings.EqualFold(l.value, "VEVENT") {
+				event, hasEnd, duration = &icsEvent{}, false, 0
+			}
+			continue
+		case "END":
+			if len(stack) == 0 || !strings.EqualFold(stack[len(stack)-1], l.value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", n+1, l.value)
+			}
+			stack = stack[:len(stack)-1]
+			if strings.EqualFold(l.value, "VEVENT") {
+				if event.Start.IsZero() {
+					return nil, fmt.Errorf("line %d: event %q has no DTSTART", n+1, event.Summary)
+				}
+				// An event without a title would fail the whole itinerary,
+				// so it is named after its location instead.
+				if event.Summary == "" {
+					event.Summary = event.Location
+					if event.Summary == "" {
+						event.Summary = "Untitled event"
+					}
+					cal.Warnings = append(cal.Warnings, fmt.Sprintf("event on %s has no SUMMARY, imported as %q", event.Start.Format(dateLayout), event.Summary))
+				}
+				if event.UnknownZone != "" {
+					cal.Warnings = append(cal.Warnings, fmt.Sprintf("%q: unknown TZID %q, times are read in %s", event.Summary, event.UnknownZone, defaultZone))
+				}
+				if !hasEnd {
+					switch {
+					case duration > 0:
+						event.End = event.Start.Add(duration)
+					case event.AllDay:
+						event.End = event.Start.AddDate(0, 0, 1)
+					default:
+						event.End = event.Start
+					}
+				}
+				cal.Events = append(cal.Events, *event)
+				event = nil
+			}
+			continue
+		}
+
+		if len(stack) == 0 {
+			return nil, fmt.Errorf("line %d: %s outside of VCALENDAR", n+1, l.name)
+		}
+		current := stack[len(stack)-1]
+
+		if current == "VCALENDAR" {
+			switch l.name {
+			case "X-WR-CALNAME":
+				cal.Name = icsUnescape(l.value)
+			case "X-WR-CALDESC":
+				cal.Description = icsUnescape(l.value)
+			case "X-WR-TIMEZONE":
+				if loc, err := time.LoadLocation(l.value); err == nil {
+					defaultZone = loc
+				}
+			}
+			continue
+		}
+
+		if current != "VEVENT" || event == nil {
+			continue
+		}
+
+		switch l.name {
+		case "UID":
+			event.UID = l.value
+		case "SUMMARY":
+			event.Summary = icsUnescape(l.value)
+		case "DESCRIPTION":
+			event.Description = icsUnescape(l.value)
+		case "LOCATION":
+			event.Location = icsUnescape(l.value)
+		case "DTSTART":
+			t, allDay, err := parseICSTime(l, icsZone(l, event, defaultZone))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n+1, err)
+			}
+			event.Start, event.AllDay = t, allDay
+		case "DTEND":
+			t, _, err := parseICSTime(l, icsZone(l, event, defaultZone))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n+1, err)
+			}
+			event.End, hasEnd = t, true
+		case "DURATION":
+			d, err := parseICSDuration(l.value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n+1, err)
+			}
+			duration = d
+		}
+	}
+
+	if len(stack) != 0 {
+		return nil, errors.New("unterminated " + stack[len(stack)-1])
+	}
+
+	return cal, nil
+}
+
+func unfoldICS(content string) []string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	var lines []string
+	for _, l := range strings.Split(content, "\n") {
+		if len(lines) > 0 && (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")) {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+		lines = append(lines, l)
+	}
+
+	return lines
+}
+
+func parseICSLine(raw string) (icsLine, error) {
+	l := icsLine{params: map[string]string{}}
+
+	quoted := false
+	colon := -1
+	for i, r := range raw {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return l, fmt.Errorf("malformed content line %q", raw)
+	}
+
+	head := splitOutsideQuotes(raw[:colon], ';')
+	l.name = strings.ToUpper(head[0])
+	l.value = raw[colon+1:]
+	for _, p := range head[1:] {
+		key, value, _ := strings.Cut(p, "=")
+		l.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return l, nil
+}
+
+func splitOutsideQuotes(s string, sep rune) []string {
+	var parts []string
+	quoted := false
+	last := 0
+	for i, r := range s {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == sep && !quoted {
+			parts = append(parts, s[last:i])
+			last = i + 1
+		}
+	}
+
+	return append(parts, s[last:])
+}
+
+// icsZone is the zone of a DATE-TIME property. A TZID that can not be loaded
+// is noted on the event, and its time is treated as floating, i.e. read in
+// the calendar's default zone, rather than failing the whole import.
+func icsZone(l icsLine, event *icsEvent, defaultZone *time.Location) *time.Location {
+	tzid := l.params["TZID"]
+	if tzid == "" {
+		return defaultZone
+	}
+
+	zone, err := time.LoadLocation(tzid)
+	if err != nil {
+		event.UnknownZone = tzid
+		return defaultZone
+	}
+
+	return zone
+}
+
+// parseICSTime reads a DATE or DATE-TIME value. Local times are read in zone.
+func parseICSTime(l icsLine, zone *time.Location) (time.Time, bool, error) {
+	if l.params["VALUE"] == "DATE" || len(l.value) == len(icsDateLayout) {
+		t, err := time.ParseInLocation(icsDateLayout, l.value, time.UTC)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(l.value, "Z") {
+		t, err := time.ParseInLocation(icsDateTimeLayout, strings.TrimSuffix(l.value, "Z"), time.UTC)
+		return t, false, err
+	}
+
+	t, err := time.ParseInLocation(icsDateTimeLayout, l.value, zone)
+	return t, false, err
+}
+
+var icsDurationRe = regexp.MustCompile(`^\+?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+func parseICSDuration(s string) (time.Duration, error) {
+	m := icsDurationRe.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("unsupported DURATION %q", s)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * unit
+	}
+
+	return d, nil
+}
+
+// itineraryFromICS maps calendar events onto stays and activities. All-day
+// events of a day or more become stays, other events and the all-day
+// activities of an exported itinerary become activities of the stay that
+// covers their day. Activities outside of every stay get a
+// one-day stay named after their location. It returns the stays ordered by
+// date and a note for every event that was left out.
+func itineraryFromICS(cal *icsCalendar) ([]*pb.Destination, []string) {
+	var stays []*pb.Destination
+	var activities []icsEvent
+	var skipped []string
+
+	for _, e := range cal.Events {
+		switch {
+		case e.AllDay && !isExportedActivity(e.UID):
+			last := e.End.AddDate(0, 0, -1)
+			if last.Before(e.Start) {
+				last = e.Start
+			}
+			stays = append(stays, &pb.Destination{
+				Name:      e.Summary,
+				StartDate: e.Start.Format(dateLayout),
+				EndDate:   last.Format(dateLayout),
+			})
+		case !e.AllDay && e.End.Sub(e.Start) >= 24*time.Hour:
+			skipped = append(skipped, fmt.Sprintf("%q: timed events longer than a day are not supported", e.Summary))
+		default:
+			activities = append(activities, e)
+		}
+	}
+
+	sort.SliceStable(stays, func(i, j int) bool { return stays[i].StartDate < stays[j].StartDate })
+	sort.SliceStable(activities, func(i, j int) bool { return activities[i].Start.Before(activities[j].Start) })
+
+	for _, e := range activities {
+		day := e.Start.Format(dateLayout)
+		act := &pb.Activity{
+			Title:    e.Summary,
+			Day:      day,
+			Location: e.Location,
+			Notes:    e.Description,
+		}
+		if !e.AllDay {
+			act.StartTime = e.Start.Format(clockLayout)
+			act.TimeZone = e.Start.Location().String()
+			if e.End.After(e.Start) {
+				act.EndTime = e.End.Format(clockLayout)
+			}
+		}
+
+		stay := stayForDay(stays, day)
+		if stay == nil {
+			name := e.Location
+			if name == "" {
+				name = e.Summary
+			}
+			stay = &pb.Destination{Name: name, StartDate: day, EndDate: day}
+			stays = append(stays, stay)
+			sort.SliceStable(stays, func(i, j int) bool { return stays[i].StartDate < stays[j].StartDate })
+		}
+		stay.Schedule = append(stay.Schedule, act)
+	}
+
+	return stays, skipped
+}
+
+// isExportedActivity tells the activities of an itinerary exported by this
+// service by their UID, since an all-day activity looks like a stay.
+func isExportedActivity(uid string) bool {
+	return strings.HasPrefix(uid, "activity-") && strings.HasSuffix(uid, "@"+icsUIDDomain)
+}
+
+func stayForDay(stays []*pb.Destination, day string) *pb.Destination {
+	// Later stays win, so a travel day that ends one stay and starts the
+	// next is booked on the new stay.
+	for i := len(stays) - 1; i >= 0; i-- {
+		if stays[i].StartDate <= day && day <= stays[i].EndDate {
+			return stays[i]
+		}
+	}
+
+	return nil
+}
+
+func parseDay(s string) (time.Time, error) {
+	if len(s) < len(dateLayout) {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+
+	return time.Parse(dateLayout, s[:len(dateLayout)])
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleItinerary() *pb.GetItineraryResponse {
+	return &pb.GetItineraryResponse{
+		Id:    "it-1",
+		Title: "Lisbon, spring",
+		Destinations: []*pb.Destination{
+			{
+				Id:         "d-1",
+				Name:       "Lisbon",
+				StartDate:  "2024-03-29",
+				EndDate:    "2024-04-01",
+				Activities: []string{"Tram 28", "Fado night"},
+				Schedule: []*pb.Activity{
+					{Id: "a-1", Title: "Tram 28", Day: "2024-03-30", StartTime: "10:00", EndTime: "11:30", TimeZone: "Europe/Lisbon", Location: "Martim Moniz"},
+					{Id: "a-2", Title: "Fado night", Day: "2024-03-31", StartTime: "22:00", EndTime: "01:00", TimeZone: "Europe/Lisbon", Notes: "Book a table; bring cash, please"},
+					{Id: "a-3", Title: "Beach day", Day: "2024-04-01", TimeZone: "Europe/Lisbon"},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderItineraryICS(t *testing.T) {
+	content, err := renderItineraryICS(sampleItinerary(), time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+
+	for _, line := range strings.Split(strings.TrimSuffix(content, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+
+	unfolded := strings.Join(unfoldICS(content), "\n")
+	assert.Contains(t, unfolded, "DTSTART;VALUE=DATE:20240329")
+	assert.Contains(t, unfolded, "DTEND;VALUE=DATE:20240402")
+	assert.Contains(t, unfolded, "DTSTART;TZID=Europe/Lisbon:20240330T100000")
+	assert.Contains(t, unfolded, "DTEND;TZID=Europe/Lisbon:20240401T010000")
+	assert.Contains(t, unfolded, `DESCRIPTION:Book a table\; bring cash\, please`)
+	// Lisbon moves to summer time at 01:00 local on the last Sunday of March.
+	assert.Contains(t, unfolded, "BEGIN:DAYLIGHT\nDTSTART:20240331T010000\nTZOFFSETFROM:+0000\nTZOFFSETTO:+0100")
+}
+
+func TestICSRoundTrip(t *testing.T) {
+	content, err := renderItineraryICS(sampleItinerary(), time.Now())
+	assert.NoError(t, err)
+
+	cal, err := parseICS(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "Lisbon, spring", cal.Name)
+
+	stays, skipped := itineraryFromICS(cal)
+	assert.Empty(t, skipped)
+	assert.Len(t, stays, 1)
+	assert.Equal(t, "Lisbon", stays[0].Name)
+	assert.Equal(t, "2024-03-29", stays[0].StartDate)
+	assert.Equal(t, "2024-04-01", stays[0].EndDate)
+	assert.Len(t, stays[0].Schedule, 3)
+
+	fado := stays[0].Schedule[1]
+	assert.Equal(t, "Fado night", fado.Title)
+	assert.Equal(t, "2024-03-31", fado.Day)
+	assert.Equal(t, "22:00", fado.StartTime)
+	assert.Equal(t, "01:00", fado.EndTime)
+	assert.Equal(t, "Europe/Lisbon", fado.TimeZone)
+	assert.Equal(t, "Book a table; bring cash, please", fado.Notes)
+
+	// The all-day activity stays an activity instead of becoming a stay.
+	beach := stays[0].Schedule[2]
+	assert.Equal(t, "Beach day", beach.Title)
+	assert.Equal(t, "2024-04-01", beach.Day)
+	assert.Empty(t, beach.StartTime)
+	for _, a := range stays[0].Schedule {
+		assert.NoError(t, validateActivity(a, stays[0].StartDate, stays[0].EndDate))
+	}
+}
+
+func TestParseICSForeignCalendar(t *testing.T) {
+	content := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"X-WR-CALNAME:Japan",
+		"BEGIN:VEVENT",
+		"UID:1",
+		"SUMMARY:Kyoto",
+		"DTSTART;VALUE=DATE:20240501",
+		"DTEND;VALUE=DATE:20240504",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:2",
+		"SUMMARY:Fushimi Inari at dawn with a very long summary that needs folding in",
+		"  the middle",
+		"DTSTART:20240501T210000Z",
+		"DURATION:PT2H",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:3",
+		"SUMMARY:Shinkansen",
+		"LOCATION:Tokyo",
+		"DTSTART;TZID=Asia/Tokyo:20240505T090000",
+		"DTEND;TZID=Asia/Tokyo:20240505T111500",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	cal, err := parseICS(content)
+	assert.NoError(t, err)
+	assert.Len(t, cal.Events, 3)
+
+	stays, _ := itineraryFromICS(cal)
+	assert.Len(t, stays, 2)
+	assert.Equal(t, "Kyoto", stays[0].Name)
+	assert.Equal(t, "2024-05-03", stays[0].EndDate)
+	assert.Equal(t, "Fushimi Inari at dawn with a very long summary that needs folding in the middle", stays[0].Schedule[0].Title)
+	assert.Equal(t, "UTC", stays[0].Schedule[0].TimeZone)
+	assert.Equal(t, "23:00", stays[0].Schedule[0].EndTime)
+	assert.Equal(t, "Tokyo", stays[1].Name)
+	assert.Equal(t, "09:00", stays[1].Schedule[0].StartTime)
+	assert.Equal(t, "Asia/Tokyo", stays[1].Schedule[0].TimeZone)
+
+	_, err = parseICS("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY:x\r\nEND:VEVENT\r\n")
+	assert.Error(t, err)
+}
+
+func TestParseICSEventProblems(t *testing.T) {
+	content := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"DTSTART;TZID=Custom/Office:20240505T090000",
+		"DTEND;TZID=Custom/Office:20240505T100000",
+		"SUMMARY:Standup",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Lunch",
+		"DTSTART;TZID=Asia/Tokyo:20240505T120000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"LOCATION:Shibuya",
+		"DTSTART;TZID=Asia/Tokyo:20240505T180000",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	cal, err := parseICS(content)
+	assert.NoError(t, err)
+	assert.Len(t, cal.Events, 3)
+	assert.Equal(t, []string{
+		`"Standup": unknown TZID "Custom/Office", times are read in UTC`,
+		`event on 2024-05-05 has no SUMMARY, imported as "Shibuya"`,
+	}, cal.Warnings)
+
+	stays, _ := itineraryFromICS(cal)
+	if assert.Len(t, stays, 1) && assert.Len(t, stays[0].Schedule, 3) {
+		// Lunch in Tokyo comes first: 12:00 there is 03:00 UTC.
+		standup := stays[0].Schedule[1]
+		assert.Equal(t, "09:00", standup.StartTime)
+		assert.Equal(t, "UTC", standup.TimeZone)
+	}
+}
@@ -6,41 +6,56 @@ import (
 	"content-service/models"
 	"content-service/storage/postgres"
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ItineraryService struct {
 	pb.UnimplementedItinerariesServiceServer
 	ItineraryRepo *postgres.ItinerariesRepo
 	Storyrepo     *postgres.TravelStoriesRepo
+	RateRepo      *postgres.ExchangeRateRepo
 	UserClient    user.AuthServiceClient
+	ShareSecret   []byte
+	AdminIds      []string
+	CuratorIds    []string
 	Logger        *slog.Logger
 }
 
 func (s *ItineraryService) CreateItinerary(ctx context.Context, in *pb.CreateItineraryRequest) (*pb.CreateItineraryResponse, error) {
-	itinerary, err := s.ItineraryRepo.CreateItinerary(in)
-	if err != nil {
-		s.Logger.Error("sayohat rejasini tuzishda xatolik", slog.String("error", err.Error()))
+	if err := validateItinerary(in); err != nil {
 		return nil, err
 	}
-
-	for _, v := range in.Distinations {
-		err = s.ItineraryRepo.CreateItineraryDestinations(models.ItineraryDestination{
-			ItineraryId: itinerary.Id,
-			Name:        v.Name,
-			StartDate:   v.StartDate,
-			EndDate:     v.EndDate,
-		})
-		if err != nil {
-			s.Logger.Error("Xatolik intinerary_destinations tablega ma'lumot qo'shishda")
+	for _, d := range in.Distinations {
+		if err := s.checkCountry(d.CountryCode); err != nil {
 			return nil, err
 		}
 	}
 
+	itinerary, err := s.ItineraryRepo.CreateItinerary(in)
+	if err != nil {
+		s.Logger.Error("sayohat rejasini tuzishda xatolik", slog.String("error", err.Error()))
+		return nil, err
+	}
+
 	return itinerary, nil
 }
 
 func (s *ItineraryService) UpdateItinerary(ctx context.Context, in *pb.UpdateItineraryRequest) (*pb.UpdateItineraryResponse, error) {
+	if in.Currency != "" && !isCurrency(in.Currency) {
+		return nil, status.Error(codes.InvalidArgument, "currency must be a three letter ISO 4217 code")
+	}
+	if _, err := s.authorize(in.Id, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
 	resp, err := s.ItineraryRepo.UpdateItinerary(in)
 	if err != nil {
 		s.Logger.Error("Sayohat rejasini yangilashda xatolik", slog.String("error", err.Error()))
@@ -51,6 +66,10 @@ func (s *ItineraryService) UpdateItinerary(ctx context.Context, in *pb.UpdateIti
 }
 
 func (s *ItineraryService) DeleteItinerary(ctx context.Context, in *pb.DeleteItineraryRequest) (*pb.DeleteItineraryResponse, error) {
+	if _, err := s.authorize(in.Id, in.UserId, models.RoleOwner); err != nil {
+		return nil, err
+	}
+
 	resp, err := s.ItineraryRepo.DeleteItinerary(in.Id)
 	if err != nil {
 		s.Logger.Error("Sayohat rejasii o'chirishda xatolik", slog.String("error", err.Error()))
@@ -60,6 +79,10 @@ func (s *ItineraryService) DeleteItinerary(ctx context.Context, in *pb.DeleteIti
 }
 
 func (s *ItineraryService) ListItineraries(ctx context.Context, in *pb.ListItinerariesRequest) (*pb.ListItinerariesResponse, error) {
+	if err := validateItineraryFilter(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	itinerary, err := s.ItineraryRepo.ListItineraries(in)
 	if err != nil {
 		s.Logger.Error("sayohat resjalarini ro'yxatini olishda xatolik", slog.String("error", err.Error()))
@@ -79,7 +102,17 @@ func (s *ItineraryService) ListItineraries(ctx context.Context, in *pb.ListItine
 }
 
 func (s *ItineraryService) GetItinerary(ctx context.Context, in *pb.GetItineraryRequest) (*pb.GetItineraryResponse, error) {
-	itinerary, err := s.ItineraryRepo.GetItinerary(in.Id)
+	if _, err := s.authorize(in.Id, in.UserId, models.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return s.loadItinerary(ctx, in.Id)
+}
+
+// loadItinerary reads an itinerary with its author, stays and schedules.
+// Callers check that the reader may see it.
+func (s *ItineraryService) loadItinerary(ctx context.Context, id string) (*pb.GetItineraryResponse, error) {
+	itinerary, err := s.ItineraryRepo.GetItinerary(id)
 	if err != nil {
 		s.Logger.Error("sayohat rejasi haqida to'liq ma'lumot olishda xatolik", slog.String("error", err.Error()))
 		return nil, err
@@ -99,9 +132,13 @@ func (s *ItineraryService) GetItinerary(ctx context.Context, in *pb.GetItinerary
 	var destinations []*pb.Destination
 	for _, v := range des {
 		var des pb.Destination
+		des.Id = v.ID
 		des.Name = v.Name
 		des.StartDate = v.StartDate
 		des.EndDate = v.EndDate
+		des.CatalogDestinationId = v.CatalogDestinationId
+		des.Coordinates = geoToPb(v.Coordinates)
+		des.CountryCode = v.CountryCode
 		act, err := s.ItineraryRepo.GetItineraryActivity(v.ID)
 		if err != nil {
 			s.Logger.Error("sayohat manzillarini activitylarini olishda xatolik", slog.String("error", err.Error()))
@@ -109,15 +146,24 @@ func (s *ItineraryService) GetItinerary(ctx context.Context, in *pb.GetItinerary
 		}
 		des.Activities = act
 
+		schedule, err := s.ItineraryRepo.GetDestinationSchedule(v.ID)
+		if err != nil {
+			s.Logger.Error("sayohat manzilining kun tartibini olishda xatolik", slog.String("error", err.Error()))
+			return nil, err
+		}
+		for _, a := range schedule {
+			des.Schedule = append(des.Schedule, activityToPb(a))
+		}
+
 		destinations = append(destinations, &des)
 	}
 
-	likeCount, err := s.Storyrepo.CountLikes(itinerary.Author.Id)
+	likeCount, err := s.ItineraryRepo.CountItineraryLikes(itinerary.Id)
 	if err != nil {
 		s.Logger.Error("Likelar sonini topishda xatolik", slog.String("error", err.Error()))
 		return nil, err
 	}
-	commentCount, err := s.Storyrepo.CountComments(itinerary.Author.Id)
+	commentCount, err := s.ItineraryRepo.CountItineraryComments(itinerary.Id)
 	if err != nil {
 		s.Logger.Error("commentlar sonini topishda xatolik", slog.String("error", err.Error()))
 		return nil, err
@@ -141,3 +187,370 @@ func (s *ItineraryService) LeaveComment(ctx context.Context, in *pb.LeaveComment
 
 	return resp, nil
 }
+
+func (s *ItineraryService) ListItineraryComments(ctx context.Context, in *pb.ListItineraryCommentsRequest) (*pb.ListItineraryCommentsResponse, error) {
+	comments, err := s.ItineraryRepo.GetItineraryComments(in)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat rejasiga yozilgan izohlarni olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	for _, comment := range comments.Comments {
+		author, err := s.UserClient.UserInfo(ctx, &user.UserInfoRequest{Id: comment.Author.Id})
+		if err != nil {
+			s.Logger.Error("Xatolik commentlarning authorlarini olishda", slog.String("error", err.Error()))
+			return nil, err
+		}
+		comment.Author.Username = author.Username
+	}
+
+	return comments, nil
+}
+
+func (s *ItineraryService) UpdateItineraryComment(ctx context.Context, in *pb.UpdateItineraryCommentRequest) (*pb.UpdateItineraryCommentResponse, error) {
+	if in.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	comment, err := s.ItineraryRepo.UpdateItineraryComment(in)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "comment not found or written by someone else")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik izohni yangilashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.UpdateItineraryCommentResponse{Comment: comment}, nil
+}
+
+func (s *ItineraryService) DeleteItineraryComment(ctx context.Context, in *pb.DeleteItineraryCommentRequest) (*pb.DeleteItineraryCommentResponse, error) {
+	comment, err := s.ItineraryRepo.GetItineraryComment(in.Id)
+	if err != nil {
+		s.Logger.Error("Xatolik izohni olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	// Authors can remove their own comments, owners moderate their itinerary.
+	if comment.Author.Id != in.UserId {
+		if _, err := s.authorize(comment.ItineraryId, in.UserId, models.RoleOwner); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.ItineraryRepo.DeleteItineraryComment(in.Id)
+	if err != nil {
+		s.Logger.Error("Xatolik izohni o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ItineraryService) LikeItinerary(ctx context.Context, in *pb.LikeItineraryRequest) (*pb.LikeItineraryResponse, error) {
+	resp, err := s.ItineraryRepo.LikeItinerary(in.ItineraryId, in.UserId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat rejasiga like bosishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ItineraryService) UnlikeItinerary(ctx context.Context, in *pb.UnlikeItineraryRequest) (*pb.UnlikeItineraryResponse, error) {
+	resp, err := s.ItineraryRepo.UnlikeItinerary(in.ItineraryId, in.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "itinerary is not liked by this user")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat rejasidan like olib tashlashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ItineraryService) AddItineraryActivity(ctx context.Context, in *pb.AddItineraryActivityRequest) (*pb.AddItineraryActivityResponse, error) {
+	if in.Activity == nil {
+		return nil, status.Error(codes.InvalidArgument, "activity is required")
+	}
+
+	destination, err := s.ItineraryRepo.GetItineraryDestination(in.DestinationId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err := s.authorize(destination.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	if err := validateActivity(in.Activity, destination.StartDate, destination.EndDate); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	act, err := s.ItineraryRepo.AddItineraryActivity(models.ItineraryActivity{
+		DestinationId: destination.ID,
+		Activity:      in.Activity.Title,
+		Day:           in.Activity.Day,
+		StartTime:     in.Activity.StartTime,
+		EndTime:       in.Activity.EndTime,
+		TimeZone:      in.Activity.TimeZone,
+		Location:      in.Activity.Location,
+		Notes:         in.Activity.Notes,
+		Coordinates:   models.GeoPointFromPb(in.Activity.Coordinates),
+	})
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manziliga activity qo'shishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.AddItineraryActivityResponse{
+		Activity:      activityToPb(*act),
+		DestinationId: act.DestinationId,
+	}, nil
+}
+
+func (s *ItineraryService) DeleteItineraryActivity(ctx context.Context, in *pb.DeleteItineraryActivityRequest) (*pb.DeleteItineraryActivityResponse, error) {
+	itineraryId, err := s.ItineraryRepo.GetActivityItineraryId(in.Id)
+	if err != nil {
+		s.Logger.Error("Xatolik activity qaysi sayohat rejasiga tegishli ekanini aniqlashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err := s.authorize(itineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.ItineraryRepo.DeleteItineraryActivity(in.Id)
+	if err != nil {
+		s.Logger.Error("Xatolik activityni o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ItineraryService) GetItineraryAgenda(ctx context.Context, in *pb.GetItineraryAgendaRequest) (*pb.GetItineraryAgendaResponse, error) {
+	var viewer *time.Location
+	if in.TimeZone != "" {
+		loc, err := time.LoadLocation(in.TimeZone)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown time zone %q", in.TimeZone)
+		}
+		viewer = loc
+	}
+
+	if err := s.authorizeRead(in.Id, in.UserId, in.ShareToken); err != nil {
+		return nil, err
+	}
+
+	itinerary, err := s.ItineraryRepo.GetItinerary(in.Id)
+	if err != nil {
+		s.Logger.Error("sayohat rejasini olishda xatolik", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	des, err := s.ItineraryRepo.GetItineraryDestinations(itinerary.Id)
+	if err != nil {
+		s.Logger.Error("Sayohat rejasidagi sayohat manzillarni olishda xatolik", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var entries []agendaEntry
+	for _, d := range des {
+		schedule, err := s.ItineraryRepo.GetDestinationSchedule(d.ID)
+		if err != nil {
+			s.Logger.Error("sayohat manzilining kun tartibini olishda xatolik", slog.String("error", err.Error()))
+			return nil, err
+		}
+		for _, a := range schedule {
+			entries = append(entries, agendaEntry{Destination: d, Activity: a})
+		}
+	}
+
+	days, err := buildAgenda(entries, viewer)
+	if err != nil {
+		s.Logger.Error("sayohat rejasining kun tartibini tuzishda xatolik", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.GetItineraryAgendaResponse{
+		ItineraryId: itinerary.Id,
+		Title:       itinerary.Title,
+		Days:        days,
+	}, nil
+}
+
+func (s *ItineraryService) ExportItineraryICS(ctx context.Context, in *pb.ExportItineraryICSRequest) (*pb.ExportItineraryICSResponse, error) {
+	if err := s.authorizeRead(in.Id, in.UserId, in.ShareToken); err != nil {
+		return nil, err
+	}
+
+	itinerary, err := s.loadItinerary(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := renderItineraryICS(itinerary, time.Now())
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat rejasini iCalendar formatiga o'tkazishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ExportItineraryICSResponse{
+		FileName:    fmt.Sprintf("itinerary-%s.ics", itinerary.Id),
+		ContentType: "text/calendar; charset=utf-8",
+		Content:     content,
+	}, nil
+}
+
+func (s *ItineraryService) ImportItineraryICS(ctx context.Context, in *pb.ImportItineraryICSRequest) (*pb.ImportItineraryICSResponse, error) {
+	cal, err := parseICS(in.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid iCalendar file: %v", err)
+	}
+
+	destinations, skipped := itineraryFromICS(cal)
+	if len(destinations) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "calendar has no events that can be imported")
+	}
+
+	req := &pb.CreateItineraryRequest{
+		Title:        in.Title,
+		Description:  in.Description,
+		StartDate:    destinations[0].StartDate,
+		EndDate:      destinations[0].EndDate,
+		AthorId:      in.AuthorId,
+		Distinations: destinations,
+	}
+	if req.Title == "" {
+		req.Title = cal.Name
+	}
+	if req.Title == "" {
+		req.Title = "Imported itinerary"
+	}
+	if req.Description == "" {
+		req.Description = cal.Description
+	}
+
+	var activities int32
+	for _, d := range destinations {
+		if d.EndDate > req.EndDate {
+			req.EndDate = d.EndDate
+		}
+		activities += int32(len(d.Schedule))
+	}
+
+	itinerary, err := s.CreateItinerary(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ImportItineraryICSResponse{
+		Itinerary:         itinerary,
+		DestinationsCount: int32(len(destinations)),
+		ActivitiesCount:   activities,
+		Skipped:           skipped,
+		Warnings:          cal.Warnings,
+	}, nil
+}
+
+func (s *ItineraryService) ForkItinerary(ctx context.Context, in *pb.ForkItineraryRequest) (*pb.ForkItineraryResponse, error) {
+	if in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if in.StartDate != "" && !isDate(in.StartDate) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start date %q, expected YYYY-MM-DD", in.StartDate)
+	}
+	if err := s.authorizeRead(in.Id, in.UserId, in.ShareToken); err != nil {
+		return nil, err
+	}
+
+	itinerary, err := s.ItineraryRepo.ForkItinerary(in.Id, in.UserId, in.StartDate, in.Title)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat rejasidan nusxa olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ForkItineraryResponse{
+		Itinerary:  itinerary,
+		ForkedFrom: in.Id,
+	}, nil
+}
+
+func activityFromPb(a *pb.Activity) models.ItineraryActivity {
+	return models.ItineraryActivity{
+		ID:          a.Id,
+		Activity:    a.Title,
+		Day:         a.Day,
+		StartTime:   a.StartTime,
+		EndTime:     a.EndTime,
+		TimeZone:    a.TimeZone,
+		Location:    a.Location,
+		Notes:       a.Notes,
+		Coordinates: models.GeoPointFromPb(a.Coordinates),
+	}
+}
+
+func activityToPb(a models.ItineraryActivity) *pb.Activity {
+	return &pb.Activity{
+		Id:          a.ID,
+		Title:       a.Activity,
+		Day:         a.Day,
+		StartTime:   a.StartTime,
+		EndTime:     a.EndTime,
+		TimeZone:    a.TimeZone,
+		Location:    a.Location,
+		Notes:       a.Notes,
+		Coordinates: geoToPb(a.Coordinates),
+	}
+}
+
+// validateItinerary checks a new itinerary before it is stored.
+func validateItinerary(in *pb.CreateItineraryRequest) error {
+	if in.Currency != "" && !isCurrency(in.Currency) {
+		return status.Error(codes.InvalidArgument, "currency must be a three letter ISO 4217 code")
+	}
+
+	for _, d := range in.Distinations {
+		if err := validateGeoPoint(d.Coordinates); err != nil {
+			return status.Errorf(codes.InvalidArgument, "stay %q: %s", d.Name, err)
+		}
+		d.CountryCode = strings.ToUpper(d.CountryCode)
+		if d.CountryCode != "" && !isCountryCode(d.CountryCode) {
+			return status.Errorf(codes.InvalidArgument, "stay %q: country_code must be an ISO 3166-1 alpha-2 code", d.Name)
+		}
+		for _, a := range d.Schedule {
+			if err := validateActivity(a, d.StartDate, d.EndDate); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateItineraryFilter checks the search options of ListItineraries.
+func validateItineraryFilter(in *pb.ListItinerariesRequest) error {
+	if in.MinDays < 0 || in.MaxDays < 0 {
+		return fmt.Errorf("trip length must not be negative")
+	}
+	if in.MaxDays > 0 && in.MinDays > in.MaxDays {
+		return fmt.Errorf("min_days %d is greater than max_days %d", in.MinDays, in.MaxDays)
+	}
+
+	for _, m := range in.Months {
+		if m < 1 || m > 12 {
+			return fmt.Errorf("month %d is outside 1-12", m)
+		}
+	}
+
+	switch in.SortBy {
+	case "", models.SortRecent, models.SortPopular:
+	default:
+		return fmt.Errorf("sort_by must be %s or %s", models.SortRecent, models.SortPopular)
+	}
+
+	return nil
+}
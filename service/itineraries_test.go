@@ -0,0 +1,35 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateItineraryFilter(t *testing.T) {
+	assert.NoError(t, validateItineraryFilter(&pb.ListItinerariesRequest{
+		Destination: "Lisbon",
+		MinDays:     7,
+		MaxDays:     10,
+		Months:      []int32{3, 4, 5},
+		SortBy:      "popular",
+	}))
+	assert.NoError(t, validateItineraryFilter(&pb.ListItinerariesRequest{MinDays: 7}))
+
+	assert.Error(t, validateItineraryFilter(&pb.ListItinerariesRequest{MinDays: 10, MaxDays: 7}))
+	assert.Error(t, validateItineraryFilter(&pb.ListItinerariesRequest{MaxDays: -1}))
+	assert.Error(t, validateItineraryFilter(&pb.ListItinerariesRequest{Months: []int32{13}}))
+	assert.Error(t, validateItineraryFilter(&pb.ListItinerariesRequest{SortBy: "oldest"}))
+}
+
+func TestValidateItineraryCountryCode(t *testing.T) {
+	in := &pb.CreateItineraryRequest{
+		Distinations: []*pb.Destination{{Name: "Lisbon", CountryCode: "pt"}},
+	}
+	assert.NoError(t, validateItinerary(in))
+	assert.Equal(t, "PT", in.Distinations[0].CountryCode)
+
+	in.Distinations[0].CountryCode = "PRT"
+	assert.Error(t, validateItinerary(in))
+}
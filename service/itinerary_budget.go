@@ -0,0 +1,375 @@
+package service
+
+import (
+	"content-service/currency"
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"math"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// expenseCategories lists the categories in the order the budget reports them.
+var expenseCategories = []string{
+	models.ExpenseAccommodation,
+	models.ExpenseTransport,
+	models.ExpenseFood,
+	models.ExpenseActivities,
+	models.ExpenseShopping,
+	models.ExpenseOther,
+}
+
+func (s *ItineraryService) LinkCatalogDestination(ctx context.Context, in *pb.LinkCatalogDestinationRequest) (*pb.LinkCatalogDestinationResponse, error) {
+	destination, err := s.ItineraryRepo.GetItineraryDestination(in.DestinationId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err := s.authorize(destination.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	err = s.ItineraryRepo.LinkCatalogDestination(destination.ID, in.CatalogDestinationId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "catalog destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini katalogdagi manzilga bog'lashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.LinkCatalogDestinationResponse{
+		DestinationId:        destination.ID,
+		CatalogDestinationId: in.CatalogDestinationId,
+	}, nil
+}
+
+func (s *ItineraryService) AddItineraryExpense(ctx context.Context, in *pb.AddItineraryExpenseRequest) (*pb.AddItineraryExpenseResponse, error) {
+	if !isExpenseCategory(in.Category) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown category %q", in.Category)
+	}
+	if in.Amount <= 0 || math.IsInf(in.Amount, 0) || math.IsNaN(in.Amount) {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if !isCurrency(in.Currency) {
+		return nil, status.Error(codes.InvalidArgument, "currency must be a three letter ISO 4217 code")
+	}
+	if in.SpentOn != "" && !isDate(in.SpentOn) {
+		return nil, status.Error(codes.InvalidArgument, "spent_on must be YYYY-MM-DD")
+	}
+
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	if in.DestinationId != "" {
+		destination, err := s.ItineraryRepo.GetItineraryDestination(in.DestinationId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "destination not found")
+		}
+		if err != nil {
+			s.Logger.Error("Xatolik sayohat manzilini olishda", slog.String("error", err.Error()))
+			return nil, err
+		}
+		if destination.ItineraryId != in.ItineraryId {
+			return nil, status.Error(codes.InvalidArgument, "destination belongs to another itinerary")
+		}
+	}
+
+	req := models.ItineraryExpense{
+		ItineraryId:   in.ItineraryId,
+		DestinationId: in.DestinationId,
+		Category:      in.Category,
+		AmountCents:   toCents(in.Amount),
+		Currency:      in.Currency,
+		Description:   in.Description,
+		SpentOn:       in.SpentOn,
+		CreatedBy:     in.UserId,
+		PaidBy:        in.PaidBy,
+		SplitType:     in.SplitType,
+	}
+	for _, p := range in.Participants {
+		req.Participants = append(req.Participants, models.ExpenseShare{
+			UserId:      p.UserId,
+			Shares:      p.Shares,
+			AmountCents: toCents(p.Amount),
+		})
+	}
+
+	if err := s.validateSplit(&req); err != nil {
+		return nil, err
+	}
+
+	expense, err := s.ItineraryRepo.AddItineraryExpense(req)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat xarajatini qo'shishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.AddItineraryExpenseResponse{Expense: expenseToPb(*expense)}, nil
+}
+
+func (s *ItineraryService) DeleteItineraryExpense(ctx context.Context, in *pb.DeleteItineraryExpenseRequest) (*pb.DeleteItineraryExpenseResponse, error) {
+	expense, err := s.ItineraryRepo.GetItineraryExpense(in.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "expense not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat xarajatini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err := s.authorize(expense.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	if err := s.ItineraryRepo.DeleteItineraryExpense(in.Id); err != nil {
+		s.Logger.Error("Xatolik sayohat xarajatini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.DeleteItineraryExpenseResponse{Message: "Expense deleted successfully"}, nil
+}
+
+func (s *ItineraryService) ListItineraryExpenses(ctx context.Context, in *pb.ListItineraryExpensesRequest) (*pb.ListItineraryExpensesResponse, error) {
+	if in.Category != "" && !isExpenseCategory(in.Category) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown category %q", in.Category)
+	}
+
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.ItineraryRepo.ListItineraryExpenses(in.ItineraryId, in.Category)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat xarajatlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var resp pb.ListItineraryExpensesResponse
+	for _, e := range expenses {
+		resp.Expenses = append(resp.Expenses, expenseToPb(e))
+	}
+
+	return &resp, nil
+}
+
+func (s *ItineraryService) GetItineraryBudget(ctx context.Context, in *pb.GetItineraryBudgetRequest) (*pb.GetItineraryBudgetResponse, error) {
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	stays, err := s.ItineraryRepo.GetStayCosts(in.ItineraryId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzillarining narxlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	expenses, err := s.ItineraryRepo.ListItineraryExpenses(in.ItineraryId, "")
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat xarajatlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rates, err := loadRates(s.RateRepo, s.Logger, in.TargetCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	var rateDate string
+	if rates != nil {
+		rateDate, err = convertBudget(rates, in.TargetCurrency, stays, expenses)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	budget := buildBudget(stays, expenses)
+	budget.ItineraryId = in.ItineraryId
+	budget.Currency = in.TargetCurrency
+	budget.RateDate = rateDate
+
+	return budget, nil
+}
+
+// convertBudget converts the stay costs and expenses in place into target
+// and returns the date of the oldest exchange rate it used.
+func convertBudget(rates *currency.Rates, target string, stays []models.StayCost, expenses []models.ItineraryExpense) (string, error) {
+	var oldest string
+
+	for i, stay := range stays {
+		if stay.DailyCostCents < 0 || stay.Currency == "" {
+			continue
+		}
+
+		cents, date, err := rates.Convert(stay.DailyCostCents, stay.Currency, target)
+		if err != nil {
+			return "", err
+		}
+
+		stays[i].DailyCostCents = cents
+		stays[i].Currency = target
+		oldest = currency.OlderDate(oldest, date)
+	}
+
+	for i, e := range expenses {
+		cents, date, err := rates.Convert(e.AmountCents, e.Currency, target)
+		if err != nil {
+			return "", err
+		}
+
+		expenses[i].AmountCents = cents
+		expenses[i].Currency = target
+		oldest = currency.OlderDate(oldest, date)
+	}
+
+	return oldest, nil
+}
+
+// buildBudget estimates every stay from its daily average and sets it against
+// the recorded expenses. Amounts are not converted here, so planned and
+// actual totals are reported per currency.
+func buildBudget(stays []models.StayCost, expenses []models.ItineraryExpense) *pb.GetItineraryBudgetResponse {
+	planned := map[string]int64{}
+	actual := map[string]int64{}
+	byStay := map[string]map[string]int64{}
+	byCategory := map[string]map[string]int64{}
+
+	for _, e := range expenses {
+		actual[e.Currency] += e.AmountCents
+		addCents(byStay, e.DestinationId, e.Currency, e.AmountCents)
+		addCents(byCategory, e.Category, e.Currency, e.AmountCents)
+	}
+
+	var resp pb.GetItineraryBudgetResponse
+
+	for _, stay := range stays {
+		item := &pb.StayBudget{
+			DestinationId:        stay.DestinationId,
+			Name:                 stay.Name,
+			CatalogDestinationId: stay.CatalogDestinationId,
+			Days:                 stay.Days,
+			Actual:               moneyList(byStay[stay.DestinationId]),
+		}
+
+		if stay.DailyCostCents >= 0 && stay.Currency != "" {
+			cost := stay.DailyCostCents * int64(stay.Days)
+			planned[stay.Currency] += cost
+
+			item.Estimated = true
+			item.DailyCost = &pb.Money{Amount: fromCents(stay.DailyCostCents), Currency: stay.Currency}
+			item.Planned = &pb.Money{Amount: fromCents(cost), Currency: stay.Currency}
+		}
+
+		resp.Stays = append(resp.Stays, item)
+	}
+
+	for _, category := range expenseCategories {
+		if amounts, ok := byCategory[category]; ok {
+			resp.Categories = append(resp.Categories, &pb.CategoryBudget{
+				Category: category,
+				Actual:   moneyList(amounts),
+			})
+		}
+	}
+
+	currencies := map[string]bool{}
+	for c := range planned {
+		currencies[c] = true
+	}
+	for c := range actual {
+		currencies[c] = true
+	}
+	for _, c := range sortedKeys(currencies) {
+		resp.Totals = append(resp.Totals, &pb.BudgetTotal{
+			Currency:  c,
+			Planned:   fromCents(planned[c]),
+			Actual:    fromCents(actual[c]),
+			Remaining: fromCents(planned[c] - actual[c]),
+		})
+	}
+
+	return &resp
+}
+
+func addCents(totals map[string]map[string]int64, key, code string, cents int64) {
+	if totals[key] == nil {
+		totals[key] = map[string]int64{}
+	}
+	totals[key][code] += cents
+}
+
+func moneyList(amounts map[string]int64) []*pb.Money {
+	var list []*pb.Money
+	for _, c := range sortedKeys(amounts) {
+		list = append(list, &pb.Money{Amount: fromCents(amounts[c]), Currency: c})
+	}
+
+	return list
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func expenseToPb(e models.ItineraryExpense) *pb.Expense {
+	var participants []*pb.ExpenseShare
+	for _, p := range e.Participants {
+		participants = append(participants, &pb.ExpenseShare{
+			UserId: p.UserId,
+			Shares: p.Shares,
+			Amount: fromCents(p.AmountCents),
+		})
+	}
+
+	return &pb.Expense{
+		Id:            e.ID,
+		ItineraryId:   e.ItineraryId,
+		DestinationId: e.DestinationId,
+		Category:      e.Category,
+		Amount:        fromCents(e.AmountCents),
+		Currency:      e.Currency,
+		Description:   e.Description,
+		SpentOn:       e.SpentOn,
+		CreatedBy:     e.CreatedBy,
+		CreatedAt:     e.CreatedAt,
+		PaidBy:        e.PaidBy,
+		SplitType:     e.SplitType,
+		Participants:  participants,
+	}
+}
+
+func isExpenseCategory(category string) bool {
+	for _, c := range expenseCategories {
+		if c == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isCurrency(code string) bool {
+	return currency.Validate(code) == nil
+}
+
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"content-service/currency"
+	"content-service/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBudgetPlannedVsActual(t *testing.T) {
+	stays := []models.StayCost{
+		{DestinationId: "d1", Name: "Lisbon", CatalogDestinationId: "c1", Days: 3, DailyCostCents: 8550, Currency: "EUR"},
+		{DestinationId: "d2", Name: "Tokyo", CatalogDestinationId: "c2", Days: 2, DailyCostCents: 1500000, Currency: "JPY"},
+		{DestinationId: "d3", Name: "Somewhere", Days: 4, DailyCostCents: -1},
+	}
+	expenses := []models.ItineraryExpense{
+		{DestinationId: "d1", Category: models.ExpenseFood, AmountCents: 4210, Currency: "EUR"},
+		{DestinationId: "d1", Category: models.ExpenseAccommodation, AmountCents: 30000, Currency: "EUR"},
+		{DestinationId: "d2", Category: models.ExpenseFood, AmountCents: 350000, Currency: "JPY"},
+		{Category: models.ExpenseTransport, AmountCents: 12000, Currency: "USD"},
+	}
+
+	budget := buildBudget(stays, expenses)
+
+	assert.Len(t, budget.Stays, 3)
+	assert.True(t, budget.Stays[0].Estimated)
+	assert.Equal(t, 256.5, budget.Stays[0].Planned.Amount)
+	assert.Equal(t, 342.1, budget.Stays[0].Actual[0].Amount)
+	assert.False(t, budget.Stays[2].Estimated)
+	assert.Nil(t, budget.Stays[2].Planned)
+
+	var categories []string
+	for _, c := range budget.Categories {
+		categories = append(categories, c.Category)
+	}
+	assert.Equal(t, []string{models.ExpenseAccommodation, models.ExpenseTransport, models.ExpenseFood}, categories)
+	assert.Len(t, budget.Categories[2].Actual, 2)
+
+	assert.Len(t, budget.Totals, 3)
+	assert.Equal(t, "EUR", budget.Totals[0].Currency)
+	assert.Equal(t, -85.6, budget.Totals[0].Remaining)
+	assert.Equal(t, "JPY", budget.Totals[1].Currency)
+	assert.Equal(t, 30000.0, budget.Totals[1].Planned)
+	assert.Equal(t, "USD", budget.Totals[2].Currency)
+	assert.Equal(t, 0.0, budget.Totals[2].Planned)
+	assert.Equal(t, 120.0, budget.Totals[2].Actual)
+}
+
+func TestIsCurrency(t *testing.T) {
+	assert.True(t, isCurrency("EUR"))
+	assert.False(t, isCurrency("eur"))
+	assert.False(t, isCurrency("EURO"))
+	assert.False(t, isCurrency(""))
+}
+
+func TestConvertBudget(t *testing.T) {
+	rates := currency.New([]models.ExchangeRate{
+		{Currency: "EUR", Rate: 0.9, RateDate: "2024-05-01"},
+		{Currency: "JPY", Rate: 150, RateDate: "2024-04-28"},
+	})
+	stays := []models.StayCost{
+		{DestinationId: "d1", Days: 2, DailyCostCents: 9000, Currency: "EUR"},
+		{DestinationId: "d2", Days: 1, DailyCostCents: -1},
+	}
+	expenses := []models.ItineraryExpense{
+		{Category: models.ExpenseFood, AmountCents: 300000, Currency: "JPY"},
+	}
+
+	rateDate, err := convertBudget(rates, "USD", stays, expenses)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-04-28", rateDate)
+
+	budget := buildBudget(stays, expenses)
+	assert.Len(t, budget.Totals, 1)
+	assert.Equal(t, "USD", budget.Totals[0].Currency)
+	assert.Equal(t, 200.0, budget.Totals[0].Planned)
+	assert.Equal(t, 20.0, budget.Totals[0].Actual)
+
+	_, err = convertBudget(rates, "USD", nil, []models.ItineraryExpense{{AmountCents: 100, Currency: "GBP"}})
+	assert.Error(t, err)
+}
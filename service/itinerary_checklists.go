@@ -0,0 +1,330 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultReminderDays = 7
+
+func (s *ItineraryService) CreateChecklist(ctx context.Context, in *pb.CreateChecklistRequest) (*pb.CreateChecklistResponse, error) {
+	if in.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	checklist, err := s.ItineraryRepo.CreateChecklist(in.ItineraryId, in.UserId, in.Title)
+	if err != nil {
+		s.Logger.Error("Xatolik checklist yaratishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.CreateChecklistResponse{Checklist: checklist}, nil
+}
+
+func (s *ItineraryService) ListChecklistTemplates(ctx context.Context, in *pb.ListChecklistTemplatesRequest) (*pb.ListChecklistTemplatesResponse, error) {
+	templates, err := s.ItineraryRepo.ListChecklistTemplates()
+	if err != nil {
+		s.Logger.Error("Xatolik checklist shablonlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ListChecklistTemplatesResponse{Templates: templates}, nil
+}
+
+func (s *ItineraryService) ApplyChecklistTemplate(ctx context.Context, in *pb.ApplyChecklistTemplateRequest) (*pb.ApplyChecklistTemplateResponse, error) {
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.ItineraryRepo.ListChecklistTemplates()
+	if err != nil {
+		s.Logger.Error("Xatolik checklist shablonlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	template := findChecklistTemplate(templates, in.Template)
+	if template == nil {
+		return nil, status.Errorf(codes.NotFound, "checklist template %q not found", in.Template)
+	}
+
+	itinerary, err := s.ItineraryRepo.GetItinerary(in.ItineraryId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "itinerary not found")
+	}
+	if err != nil {
+		s.Logger.Error("sayohat rejasini olishda xatolik", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	items, err := expandChecklistTemplate(template, itinerary.StartDate)
+	if err != nil {
+		s.Logger.Error("Xatolik checklist shablonini qo'llashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	checklist, err := s.ItineraryRepo.CreateChecklistFromTemplate(in.ItineraryId, in.UserId, template.Name, items)
+	if err != nil {
+		s.Logger.Error("Xatolik checklist shablonini qo'llashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ApplyChecklistTemplateResponse{Checklist: checklist}, nil
+}
+
+func (s *ItineraryService) ListChecklists(ctx context.Context, in *pb.ListChecklistsRequest) (*pb.ListChecklistsResponse, error) {
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	checklists, err := s.ItineraryRepo.ListChecklists(in.ItineraryId)
+	if err != nil {
+		s.Logger.Error("Xatolik checklistlarni olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ListChecklistsResponse{Checklists: checklists}, nil
+}
+
+func (s *ItineraryService) DeleteChecklist(ctx context.Context, in *pb.DeleteChecklistRequest) (*pb.DeleteChecklistResponse, error) {
+	itineraryId, err := s.checklistItinerary(in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(itineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	if err := s.ItineraryRepo.DeleteChecklist(in.Id); err != nil {
+		s.Logger.Error("Xatolik checklistni o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.DeleteChecklistResponse{Message: "Checklist deleted successfully"}, nil
+}
+
+func (s *ItineraryService) AddChecklistItem(ctx context.Context, in *pb.AddChecklistItemRequest) (*pb.AddChecklistItemResponse, error) {
+	if in.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if in.DueDate != "" && !isDate(in.DueDate) {
+		return nil, status.Error(codes.InvalidArgument, "due_date must be YYYY-MM-DD")
+	}
+
+	itineraryId, err := s.checklistItinerary(in.ChecklistId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(itineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+	if err := s.checkAssignee(itineraryId, in.AssigneeId); err != nil {
+		return nil, err
+	}
+
+	item, err := s.ItineraryRepo.AddChecklistItem(in.ChecklistId, in.Title, in.AssigneeId, in.DueDate)
+	if err != nil {
+		s.Logger.Error("Xatolik checklistga element qo'shishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.AddChecklistItemResponse{Item: item}, nil
+}
+
+func (s *ItineraryService) UpdateChecklistItem(ctx context.Context, in *pb.UpdateChecklistItemRequest) (*pb.UpdateChecklistItemResponse, error) {
+	if in.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if in.DueDate != "" && !isDate(in.DueDate) {
+		return nil, status.Error(codes.InvalidArgument, "due_date must be YYYY-MM-DD")
+	}
+
+	_, itineraryId, err := s.checklistItem(in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(itineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+	if err := s.checkAssignee(itineraryId, in.AssigneeId); err != nil {
+		return nil, err
+	}
+
+	item, err := s.ItineraryRepo.UpdateChecklistItem(in.Id, in.Title, in.AssigneeId, in.DueDate)
+	if err != nil {
+		s.Logger.Error("Xatolik checklist elementini yangilashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.UpdateChecklistItemResponse{Item: item}, nil
+}
+
+func (s *ItineraryService) SetChecklistItemDone(ctx context.Context, in *pb.SetChecklistItemDoneRequest) (*pb.SetChecklistItemDoneResponse, error) {
+	item, itineraryId, err := s.checklistItem(in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(itineraryId, in.UserId, tickRole(item, in.UserId)); err != nil {
+		return nil, err
+	}
+
+	item, err = s.ItineraryRepo.SetChecklistItemDone(in.Id, in.UserId, in.Done)
+	if err != nil {
+		s.Logger.Error("Xatolik checklist elementini belgilashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.SetChecklistItemDoneResponse{Item: item}, nil
+}
+
+func (s *ItineraryService) DeleteChecklistItem(ctx context.Context, in *pb.DeleteChecklistItemRequest) (*pb.DeleteChecklistItemResponse, error) {
+	_, itineraryId, err := s.checklistItem(in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorize(itineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	if err := s.ItineraryRepo.DeleteChecklistItem(in.Id); err != nil {
+		s.Logger.Error("Xatolik checklist elementini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.DeleteChecklistItemResponse{Message: "Checklist item deleted successfully"}, nil
+}
+
+func (s *ItineraryService) GetChecklistReminders(ctx context.Context, in *pb.GetChecklistRemindersRequest) (*pb.GetChecklistRemindersResponse, error) {
+	if in.UserId == "" {
+		return nil, status.Error(codes.Unauthenticated, "user_id is required")
+	}
+
+	today, days, err := reminderWindow(time.Now(), in.WithinDays)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	reminders, err := s.ItineraryRepo.GetChecklistReminders(in.UserId, today, days)
+	if err != nil {
+		s.Logger.Error("Xatolik checklist eslatmalarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.GetChecklistRemindersResponse{Reminders: reminders}, nil
+}
+
+func (s *ItineraryService) checklistItinerary(checklistId string) (string, error) {
+	itineraryId, err := s.ItineraryRepo.GetChecklistItineraryId(checklistId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", status.Error(codes.NotFound, "checklist not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik checklist qaysi sayohat rejasiga tegishli ekanini aniqlashda", slog.String("error", err.Error()))
+		return "", err
+	}
+
+	return itineraryId, nil
+}
+
+func (s *ItineraryService) checklistItem(id string) (*pb.ChecklistItem, string, error) {
+	item, itineraryId, err := s.ItineraryRepo.GetChecklistItem(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", status.Error(codes.NotFound, "checklist item not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik checklist elementini olishda", slog.String("error", err.Error()))
+		return nil, "", err
+	}
+
+	return item, itineraryId, nil
+}
+
+// checkAssignee makes sure items are only assigned to members of the trip.
+func (s *ItineraryService) checkAssignee(itineraryId, assigneeId string) error {
+	if assigneeId == "" {
+		return nil
+	}
+
+	_, err := s.ItineraryRepo.GetMemberRole(itineraryId, assigneeId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.InvalidArgument, "assignee is not a member of this itinerary")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik foydalanuvchining sayohat rejasidagi rolini olishda", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// tickRole is the role needed to tick off item. Whoever the item is assigned
+// to may do it even as a viewer.
+func tickRole(item *pb.ChecklistItem, userId string) string {
+	if item.AssigneeId != "" && item.AssigneeId == userId {
+		return models.RoleViewer
+	}
+
+	return models.RoleEditor
+}
+
+// reminderWindow returns the first day, in UTC, and the length in days of
+// the window trips must start in to get reminders.
+func reminderWindow(now time.Time, withinDays int32) (string, int32, error) {
+	if withinDays < 0 {
+		return "", 0, errors.New("within_days must not be negative")
+	}
+	if withinDays == 0 {
+		withinDays = defaultReminderDays
+	}
+
+	return now.UTC().Format(dateLayout), withinDays, nil
+}
+
+func findChecklistTemplate(templates []*pb.ChecklistTemplate, name string) *pb.ChecklistTemplate {
+	for _, template := range templates {
+		if template.Name == name {
+			return template
+		}
+	}
+
+	return nil
+}
+
+// expandChecklistTemplate turns the items of a template into checklist items
+// due their number of days before the trip starts on startDate.
+func expandChecklistTemplate(template *pb.ChecklistTemplate, startDate string) ([]*pb.ChecklistItem, error) {
+	if len(startDate) < len(dateLayout) {
+		return nil, fmt.Errorf("invalid itinerary start date %q", startDate)
+	}
+	start, err := time.Parse(dateLayout, startDate[:len(dateLayout)])
+	if err != nil {
+		return nil, fmt.Errorf("invalid itinerary start date %q", startDate)
+	}
+
+	items := make([]*pb.ChecklistItem, 0, len(template.Items))
+	for _, t := range template.Items {
+		item := &pb.ChecklistItem{Title: t.Title}
+		if t.DaysBeforeStart >= 0 {
+			item.DueDate = start.AddDate(0, 0, -int(t.DaysBeforeStart)).Format(dateLayout)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandChecklistTemplate(t *testing.T) {
+	templates := []*pb.ChecklistTemplate{
+		{Name: "business"},
+		{Name: "beach", Items: []*pb.ChecklistTemplateItem{
+			{Title: "Check passport validity", DaysBeforeStart: 30},
+			{Title: "Swimwear", DaysBeforeStart: 1},
+			{Title: "Pack on the day", DaysBeforeStart: 0},
+			{Title: "Enjoy", DaysBeforeStart: -1},
+		}},
+	}
+
+	assert.Nil(t, findChecklistTemplate(templates, "moon"))
+	beach := findChecklistTemplate(templates, "beach")
+	if !assert.NotNil(t, beach) {
+		return
+	}
+
+	// Itinerary dates come back from the database with a time part.
+	items, err := expandChecklistTemplate(beach, "2024-03-29T00:00:00Z")
+	assert.NoError(t, err)
+	if assert.Len(t, items, 4) {
+		assert.Equal(t, "Check passport validity", items[0].Title)
+		assert.Equal(t, "2024-02-28", items[0].DueDate)
+		assert.Equal(t, "2024-03-28", items[1].DueDate)
+		assert.Equal(t, "2024-03-29", items[2].DueDate)
+		assert.Empty(t, items[3].DueDate)
+	}
+
+	_, err = expandChecklistTemplate(beach, "")
+	assert.Error(t, err)
+}
+
+func TestTickRole(t *testing.T) {
+	assigned := &pb.ChecklistItem{AssigneeId: "u1"}
+
+	assert.Equal(t, models.RoleViewer, tickRole(assigned, "u1"))
+	assert.Equal(t, models.RoleEditor, tickRole(assigned, "u2"))
+	assert.Equal(t, models.RoleEditor, tickRole(&pb.ChecklistItem{}, "u1"))
+	assert.Equal(t, models.RoleEditor, tickRole(&pb.ChecklistItem{}, ""))
+}
+
+func TestReminderWindow(t *testing.T) {
+	// The window starts on the current date in UTC, whatever the zone of now.
+	now := time.Date(2024, 3, 30, 23, 30, 0, 0, time.FixedZone("UZT", 5*60*60))
+
+	today, days, err := reminderWindow(now, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-30", today)
+	assert.Equal(t, int32(defaultReminderDays), days)
+
+	now = time.Date(2024, 3, 31, 2, 0, 0, 0, time.FixedZone("UZT", 5*60*60))
+	today, days, err = reminderWindow(now, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-30", today)
+	assert.Equal(t, int32(3), days)
+
+	_, _, err = reminderWindow(now, -1)
+	assert.Error(t, err)
+}
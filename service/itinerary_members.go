@@ -0,0 +1,155 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/generated/user"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var roleRank = map[string]int{
+	models.RoleViewer: 1,
+	models.RoleEditor: 2,
+	models.RoleOwner:  3,
+}
+
+// authorize makes sure userId is an accepted member of the itinerary with at
+// least minRole and returns the member's role.
+func (s *ItineraryService) authorize(itineraryId, userId, minRole string) (string, error) {
+	if userId == "" {
+		return "", status.Error(codes.Unauthenticated, "user_id is required")
+	}
+
+	role, err := s.ItineraryRepo.GetMemberRole(itineraryId, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", status.Error(codes.PermissionDenied, "user is not a member of this itinerary")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik foydalanuvchining sayohat rejasidagi rolini olishda", slog.String("error", err.Error()))
+		return "", err
+	}
+
+	if roleRank[role] < roleRank[minRole] {
+		return "", status.Errorf(codes.PermissionDenied, "%s role is required", minRole)
+	}
+
+	return role, nil
+}
+
+func (s *ItineraryService) InviteItineraryMember(ctx context.Context, in *pb.InviteItineraryMemberRequest) (*pb.InviteItineraryMemberResponse, error) {
+	if in.Role != models.RoleEditor && in.Role != models.RoleViewer {
+		return nil, status.Error(codes.InvalidArgument, "role must be editor or viewer")
+	}
+	if in.UserId == "" || in.UserId == in.InviterId {
+		return nil, status.Error(codes.InvalidArgument, "invalid user to invite")
+	}
+
+	// Editors may bring in viewers, only the owner can hand out edit rights.
+	minRole := models.RoleEditor
+	if in.Role == models.RoleEditor {
+		minRole = models.RoleOwner
+	}
+	if _, err := s.authorize(in.ItineraryId, in.InviterId, minRole); err != nil {
+		return nil, err
+	}
+
+	invitee, err := s.UserClient.UserInfo(ctx, &user.UserInfoRequest{Id: in.UserId})
+	if err != nil {
+		s.Logger.Error("Taklif qilinayotgan user haqida ma'lumot olishda xatolik", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	member, err := s.ItineraryRepo.InviteMember(in.ItineraryId, in.InviterId, in.UserId, in.Role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.AlreadyExists, "user is already a member or has a pending invitation")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik userni sayohat rejasiga taklif qilishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	member.Username = invitee.Username
+
+	return &pb.InviteItineraryMemberResponse{Member: member}, nil
+}
+
+func (s *ItineraryService) AcceptItineraryInvitation(ctx context.Context, in *pb.AcceptItineraryInvitationRequest) (*pb.AcceptItineraryInvitationResponse, error) {
+	member, err := s.ItineraryRepo.AcceptInvitation(in.ItineraryId, in.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "no pending invitation for this itinerary")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik taklifni qabul qilishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.AcceptItineraryInvitationResponse{Member: member}, nil
+}
+
+func (s *ItineraryService) RemoveItineraryMember(ctx context.Context, in *pb.RemoveItineraryMemberRequest) (*pb.RemoveItineraryMemberResponse, error) {
+	if in.RequesterId != in.UserId {
+		if _, err := s.authorize(in.ItineraryId, in.RequesterId, models.RoleOwner); err != nil {
+			return nil, err
+		}
+	} else if in.UserId == "" {
+		return nil, status.Error(codes.Unauthenticated, "requester_id is required")
+	}
+
+	resp, err := s.ItineraryRepo.RemoveMember(in.ItineraryId, in.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "member not found, the owner can not be removed")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik a'zoni sayohat rejasidan o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ItineraryService) ListItineraryMembers(ctx context.Context, in *pb.ListItineraryMembersRequest) (*pb.ListItineraryMembersResponse, error) {
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	members, err := s.ItineraryRepo.ListMembers(in.ItineraryId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat rejasi a'zolarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	for _, m := range members {
+		info, err := s.UserClient.UserInfo(ctx, &user.UserInfoRequest{Id: m.UserId})
+		if err != nil {
+			s.Logger.Error("Xatolik a'zo haqida ma'lumot olishda", slog.String("error", err.Error()))
+			return nil, err
+		}
+		m.Username = info.Username
+	}
+
+	return &pb.ListItineraryMembersResponse{Members: members}, nil
+}
+
+func (s *ItineraryService) ListMyItineraries(ctx context.Context, in *pb.ListMyItinerariesRequest) (*pb.ListMyItinerariesResponse, error) {
+	resp, err := s.ItineraryRepo.ListMyItineraries(in)
+	if err != nil {
+		s.Logger.Error("Xatolik userning sayohat rejalarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	for _, v := range resp.Itineraries {
+		author, err := s.UserClient.UserInfo(ctx, &user.UserInfoRequest{Id: v.Itinerary.Author.Id})
+		if err != nil {
+			s.Logger.Error("Sahoyat rejasini tuzgan inson malumotini olishda xatolik", slog.String("error", err.Error()))
+			return nil, err
+		}
+		v.Itinerary.Author.Username = author.Username
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const roleCurator = "curator"
+
+func (s *ItineraryService) CreateItineraryTemplate(ctx context.Context, in *pb.CreateItineraryTemplateRequest) (*pb.CreateItineraryTemplateResponse, error) {
+	if _, err := s.requireCurator(in.UserId); err != nil {
+		return nil, err
+	}
+
+	days, err := validateTemplate(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	template, err := s.ItineraryRepo.CreateItineraryTemplate(in, days)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat shablonini yaratishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.CreateItineraryTemplateResponse{Template: template}, nil
+}
+
+func (s *ItineraryService) ListItineraryTemplates(ctx context.Context, in *pb.ListItineraryTemplatesRequest) (*pb.ListItineraryTemplatesResponse, error) {
+	resp, err := s.ItineraryRepo.ListItineraryTemplates(in)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat shablonlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ItineraryService) GetItineraryTemplate(ctx context.Context, in *pb.GetItineraryTemplateRequest) (*pb.GetItineraryTemplateResponse, error) {
+	template, err := s.ItineraryRepo.GetItineraryTemplate(in.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "template not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat shablonini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.GetItineraryTemplateResponse{Template: template}, nil
+}
+
+func (s *ItineraryService) DeleteItineraryTemplate(ctx context.Context, in *pb.DeleteItineraryTemplateRequest) (*pb.DeleteItineraryTemplateResponse, error) {
+	role, err := s.requireCurator(in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.ItineraryRepo.GetItineraryTemplate(in.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "template not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat shablonini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	// Curators manage their own templates, admins can take down any of them.
+	if template.AuthorId != in.UserId && role != roleAdmin {
+		return nil, status.Error(codes.PermissionDenied, "template was published by another curator")
+	}
+
+	if err := s.ItineraryRepo.DeleteItineraryTemplate(in.Id); err != nil {
+		s.Logger.Error("Xatolik sayohat shablonini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.DeleteItineraryTemplateResponse{Message: "Template deleted successfully"}, nil
+}
+
+func (s *ItineraryService) InstantiateTemplate(ctx context.Context, in *pb.InstantiateTemplateRequest) (*pb.InstantiateTemplateResponse, error) {
+	if in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	start, err := time.Parse(dateLayout, in.StartDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date must be YYYY-MM-DD")
+	}
+
+	template, err := s.ItineraryRepo.GetItineraryTemplate(in.TemplateId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "template not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat shablonini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	req := instantiateTemplate(template, start, in.UserId, in.Title)
+	req.Currency = in.Currency
+
+	if err := validateItinerary(req); err != nil {
+		return nil, err
+	}
+
+	itinerary, err := s.ItineraryRepo.InstantiateTemplate(template.Id, req)
+	if err != nil {
+		s.Logger.Error("Xatolik shablondan sayohat rejasini tuzishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.InstantiateTemplateResponse{
+		Itinerary:  itinerary,
+		TemplateId: template.Id,
+	}, nil
+}
+
+// requireCurator only lets the curators and administrators listed in
+// CuratorIds and AdminIds through and returns the role of userId.
+func (s *ItineraryService) requireCurator(userId string) (string, error) {
+	if userId == "" {
+		return "", status.Error(codes.Unauthenticated, "user_id is required")
+	}
+
+	switch {
+	case slices.Contains(s.AdminIds, userId):
+		return roleAdmin, nil
+	case slices.Contains(s.CuratorIds, userId):
+		return roleCurator, nil
+	}
+
+	return "", status.Error(codes.PermissionDenied, "curator role is required")
+}
+
+// validateTemplate checks the relative days of a template and returns the
+// length of the trip in days.
+func validateTemplate(in *pb.CreateItineraryTemplateRequest) (int32, error) {
+	if in.Title == "" {
+		return 0, fmt.Errorf("title is required")
+	}
+	if len(in.Stops) == 0 {
+		return 0, fmt.Errorf("a template needs at least one stop")
+	}
+
+	var days int32
+	for _, stop := range in.Stops {
+		if stop.Name == "" {
+			return 0, fmt.Errorf("stop name is required")
+		}
+		if stop.StartDay < 1 || stop.EndDay < stop.StartDay {
+			return 0, fmt.Errorf("stop %q must run from day 1 or later and end on or after its first day", stop.Name)
+		}
+		days = max(days, stop.EndDay)
+
+		for _, a := range stop.Activities {
+			if a.Title == "" {
+				return 0, fmt.Errorf("activity title is required")
+			}
+			if _, err := loadZone(a.TimeZone); err != nil {
+				return 0, fmt.Errorf("unknown time zone %q", a.TimeZone)
+			}
+			if a.Day == 0 {
+				if a.StartTime != "" || a.EndTime != "" {
+					return 0, fmt.Errorf("activity %q has a time but no day", a.Title)
+				}
+				continue
+			}
+			if a.Day < stop.StartDay || a.Day > stop.EndDay {
+				return 0, fmt.Errorf("day %d of activity %q is outside days %d-%d of %q", a.Day, a.Title, stop.StartDay, stop.EndDay, stop.Name)
+			}
+			if a.StartTime != "" && !isClock(a.StartTime) {
+				return 0, fmt.Errorf("invalid start time %q, expected HH:MM", a.StartTime)
+			}
+			if a.EndTime != "" && (a.StartTime == "" || !isClock(a.EndTime)) {
+				return 0, fmt.Errorf("invalid end time %q of activity %q", a.EndTime, a.Title)
+			}
+		}
+	}
+
+	return days, nil
+}
+
+// instantiateTemplate turns the relative days of a template into dates, with
+// day 1 falling on start.
+func instantiateTemplate(t *pb.ItineraryTemplate, start time.Time, userId, title string) *pb.CreateItineraryRequest {
+	date := func(day int32) string {
+		return start.AddDate(0, 0, int(day)-1).Format(dateLayout)
+	}
+
+	if title == "" {
+		title = t.Title
+	}
+
+	req := &pb.CreateItineraryRequest{
+		Title:       title,
+		Description: t.Description,
+		StartDate:   date(1),
+		EndDate:     date(t.Days),
+		AthorId:     userId,
+	}
+
+	for _, stop := range t.Stops {
+		d := &pb.Destination{
+			Name:                 stop.Name,
+			StartDate:            date(stop.StartDay),
+			EndDate:              date(stop.EndDay),
+			CatalogDestinationId: stop.CatalogDestinationId,
+		}
+
+		for _, a := range stop.Activities {
+			act := &pb.Activity{
+				Title:     a.Title,
+				StartTime: a.StartTime,
+				EndTime:   a.EndTime,
+				TimeZone:  a.TimeZone,
+				Location:  a.Location,
+				Notes:     a.Notes,
+			}
+			if a.Day > 0 {
+				act.Day = date(a.Day)
+			}
+			d.Schedule = append(d.Schedule, act)
+		}
+
+		req.Distinations = append(req.Distinations, d)
+	}
+
+	return req
+}
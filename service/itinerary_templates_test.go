@@ -0,0 +1,68 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func kansaiTemplate() *pb.CreateItineraryTemplateRequest {
+	return &pb.CreateItineraryTemplateRequest{
+		Title: "Kansai in a week",
+		Stops: []*pb.TemplateStop{
+			{Name: "Kyoto", StartDay: 1, EndDay: 3, Activities: []*pb.TemplateActivity{
+				{Title: "Fushimi Inari at dawn", Day: 2, StartTime: "06:00", TimeZone: "Asia/Tokyo"},
+				{Title: "Try matcha"},
+			}},
+			{Name: "Nara", StartDay: 4, EndDay: 4},
+			{Name: "Osaka", StartDay: 5, EndDay: 7},
+		},
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	days, err := validateTemplate(kansaiTemplate())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), days)
+
+	bad := kansaiTemplate()
+	bad.Stops[0].Activities[0].Day = 4
+	_, err = validateTemplate(bad)
+	assert.ErrorContains(t, err, "outside days 1-3")
+
+	bad = kansaiTemplate()
+	bad.Stops[1].StartDay = 0
+	_, err = validateTemplate(bad)
+	assert.Error(t, err)
+
+	bad = kansaiTemplate()
+	bad.Stops[0].Activities[1].StartTime = "09:00"
+	_, err = validateTemplate(bad)
+	assert.Error(t, err)
+}
+
+func TestInstantiateTemplate(t *testing.T) {
+	in := kansaiTemplate()
+	days, _ := validateTemplate(in)
+	template := &pb.ItineraryTemplate{Title: in.Title, Days: days, Stops: in.Stops}
+
+	start := time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC)
+	req := instantiateTemplate(template, start, "u1", "")
+
+	assert.Equal(t, "Kansai in a week", req.Title)
+	assert.Equal(t, "2024-03-30", req.StartDate)
+	assert.Equal(t, "2024-04-05", req.EndDate)
+	assert.Equal(t, "u1", req.AthorId)
+
+	assert.Len(t, req.Distinations, 3)
+	kyoto := req.Distinations[0]
+	assert.Equal(t, "2024-03-30", kyoto.StartDate)
+	assert.Equal(t, "2024-04-01", kyoto.EndDate)
+	assert.Equal(t, "2024-03-31", kyoto.Schedule[0].Day)
+	assert.Equal(t, "", kyoto.Schedule[1].Day)
+	assert.Equal(t, "2024-04-02", req.Distinations[1].StartDate)
+
+	assert.NoError(t, validateItinerary(req))
+}
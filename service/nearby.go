@@ -0,0 +1,56 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultNearbyLimit = 20
+	maxNearbyLimit     = 100
+	// maxNearbyRadiusKm is half the circumference of the Earth, every place
+	// is closer than that.
+	maxNearbyRadiusKm = 20037.5
+)
+
+// ListNearbyDestinations finds catalog entries within a radius of a point,
+// nearest first. Destinations without coordinates are never returned.
+func (s *DestinationService) ListNearbyDestinations(ctx context.Context, in *pb.ListNearbyDestinationsRequest) (*pb.ListNearbyDestinationsResponse, error) {
+	if in.Center == nil {
+		return nil, status.Error(codes.InvalidArgument, "center is required")
+	}
+	if err := validateDestinationPoint(in.Center); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if in.RadiusKm <= 0 || in.RadiusKm > maxNearbyRadiusKm {
+		return nil, status.Errorf(codes.InvalidArgument, "radius_km must be greater than 0 and at most %v", maxNearbyRadiusKm)
+	}
+	if in.Limit < 0 || in.Limit > maxNearbyLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxNearbyLimit)
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = defaultNearbyLimit
+	}
+
+	destinations, err := s.DestinationRepo.ListNearbyDestinations(in.Center, in.RadiusKm, limit)
+	if err != nil {
+		s.Logger.Error("Xatolik yaqin atrofdagi sayohat manzillarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ListNearbyDestinationsResponse{Destinations: destinations}, nil
+}
+
+func validateDestinationPoint(p *pb.GeoPoint) error {
+	if p == nil {
+		return nil
+	}
+
+	return validateCoordinates(p.Latitude, p.Longitude)
+}
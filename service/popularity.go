@@ -0,0 +1,81 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/popularity"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *DestinationService) BookmarkDestination(ctx context.Context, in *pb.BookmarkDestinationRequest) (*pb.BookmarkDestinationResponse, error) {
+	if in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	err := s.DestinationRepo.BookmarkDestination(in.UserId, in.DestinationId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini saqlab qo'yishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.BookmarkDestinationResponse{Message: "Destination bookmarked successfully"}, nil
+}
+
+func (s *DestinationService) RemoveDestinationBookmark(ctx context.Context, in *pb.RemoveDestinationBookmarkRequest) (*pb.RemoveDestinationBookmarkResponse, error) {
+	err := s.DestinationRepo.RemoveDestinationBookmark(in.UserId, in.DestinationId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "bookmark not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik saqlangan sayohat manzilini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.RemoveDestinationBookmarkResponse{Message: "Bookmark removed successfully"}, nil
+}
+
+// RefreshPopularity recomputes the popularity score and trending reason of
+// every destination from the engagement of the last popularity.Window.
+func (s *DestinationService) RefreshPopularity(now time.Time) error {
+	engagement, err := s.DestinationRepo.ListDestinationEngagement(now.Add(-popularity.Window))
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzillari faolligini olishda", slog.String("error", err.Error()))
+		return err
+	}
+
+	if err = s.DestinationRepo.SaveDestinationScores(popularity.Score(engagement, now)); err != nil {
+		s.Logger.Error("Xatolik sayohat manzillari reytingini saqlashda", slog.String("error", err.Error()))
+		return err
+	}
+	s.invalidateTrending(context.Background())
+
+	return nil
+}
+
+// RunPopularityJob refreshes popularity right away and then every interval
+// until ctx is done. Failed runs are logged and retried on the next tick.
+func (s *DestinationService) RunPopularityJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RefreshPopularity(time.Now()); err == nil {
+			s.Logger.Info("Sayohat manzillari reytingi yangilandi")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
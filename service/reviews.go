@@ -0,0 +1,187 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	monthLayout = "2006-01"
+
+	maxReviewLength    = 5000
+	defaultReviewLimit = 20
+	maxReviewLimit     = 100
+)
+
+func (s *DestinationService) AddDestinationReview(ctx context.Context, in *pb.AddDestinationReviewRequest) (*pb.AddDestinationReviewResponse, error) {
+	if in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := validateReview(in.Rating, in.Content, in.VisitMonth, time.Now()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkDestination(in.DestinationId); err != nil {
+		return nil, err
+	}
+
+	review, err := s.DestinationRepo.CreateReview(in)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.AlreadyExists, "you have already reviewed this destination")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manziliga sharh qo'shishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.AddDestinationReviewResponse{Review: review}, nil
+}
+
+func (s *DestinationService) UpdateDestinationReview(ctx context.Context, in *pb.UpdateDestinationReviewRequest) (*pb.UpdateDestinationReviewResponse, error) {
+	if err := validateReview(in.Rating, in.Content, in.VisitMonth, time.Now()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	review, err := s.DestinationRepo.UpdateReview(in)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "review not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili sharhini yangilashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.UpdateDestinationReviewResponse{Review: review}, nil
+}
+
+func (s *DestinationService) DeleteDestinationReview(ctx context.Context, in *pb.DeleteDestinationReviewRequest) (*pb.DeleteDestinationReviewResponse, error) {
+	err := s.DestinationRepo.DeleteReview(in.Id, in.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "review not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili sharhini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.DeleteDestinationReviewResponse{Message: "Review deleted successfully"}, nil
+}
+
+// VoteReviewHelpful marks a review as helpful, or takes the mark back when
+// helpful is false. Authors cannot vote on their own reviews.
+func (s *DestinationService) VoteReviewHelpful(ctx context.Context, in *pb.VoteReviewHelpfulRequest) (*pb.VoteReviewHelpfulResponse, error) {
+	if in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	review, err := s.DestinationRepo.GetReview(in.ReviewId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "review not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili sharhini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	if review.UserId == in.UserId {
+		return nil, status.Error(codes.PermissionDenied, "you cannot vote on your own review")
+	}
+
+	count, err := s.DestinationRepo.SetReviewVote(in.ReviewId, in.UserId, in.Helpful)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "review not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili sharhiga ovoz berishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.VoteReviewHelpfulResponse{HelpfulCount: count}, nil
+}
+
+func (s *DestinationService) ListDestinationReviews(ctx context.Context, in *pb.ListDestinationReviewsRequest) (*pb.ListDestinationReviewsResponse, error) {
+	if err := normalizeReviewList(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkDestination(in.DestinationId); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.DestinationRepo.ListReviews(in)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili sharhlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// checkDestination makes sure a live destination exists.
+func (s *DestinationService) checkDestination(id string) error {
+	_, err := s.DestinationRepo.GetTravelDestination(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini olishda", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// validateReview checks a review. The visit month is optional, in the form
+// 2024-05, and cannot be later than the month of now.
+func validateReview(rating int32, content, visitMonth string, now time.Time) error {
+	if rating < 1 || rating > 5 {
+		return errors.New("rating must be between 1 and 5")
+	}
+	if len([]rune(content)) > maxReviewLength {
+		return errors.New("content is too long")
+	}
+	if visitMonth == "" {
+		return nil
+	}
+
+	month, err := time.Parse(monthLayout, visitMonth)
+	if err != nil {
+		return errors.New("visit_month must be in the format YYYY-MM")
+	}
+	if month.Format(monthLayout) > now.Format(monthLayout) {
+		return errors.New("visit_month must not be in the future")
+	}
+
+	return nil
+}
+
+// normalizeReviewList fills in the defaults of a review list request and
+// checks the rest of it.
+func normalizeReviewList(in *pb.ListDestinationReviewsRequest) error {
+	if in.Page < 0 || in.Limit < 0 || in.Limit > maxReviewLimit {
+		return errors.New("page must be positive and limit between 1 and 100")
+	}
+	if in.Page == 0 {
+		in.Page = 1
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultReviewLimit
+	}
+
+	in.SortBy = strings.ToLower(in.SortBy)
+	switch in.SortBy {
+	case "":
+		in.SortBy = models.SortRecent
+	case models.SortRecent, models.SortHelpful, models.SortHighest, models.SortLowest:
+	default:
+		return errors.New("sort_by must be one of recent, helpful, highest, lowest")
+	}
+
+	return nil
+}
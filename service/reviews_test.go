@@ -0,0 +1,41 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReview(t *testing.T) {
+	now := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, validateReview(1, "", "", now))
+	assert.NoError(t, validateReview(5, "Great food", "2024-06", now))
+	assert.NoError(t, validateReview(3, "", "2019-12", now))
+
+	assert.Error(t, validateReview(0, "", "", now))
+	assert.Error(t, validateReview(6, "", "", now))
+	assert.Error(t, validateReview(4, "", "2024-07", now))
+	assert.Error(t, validateReview(4, "", "2024-6", now))
+	assert.Error(t, validateReview(4, "", "June 2024", now))
+	assert.Error(t, validateReview(4, string(make([]rune, maxReviewLength+1)), "", now))
+}
+
+func TestNormalizeReviewList(t *testing.T) {
+	in := &pb.ListDestinationReviewsRequest{}
+	assert.NoError(t, normalizeReviewList(in))
+	assert.Equal(t, int32(1), in.Page)
+	assert.Equal(t, int32(defaultReviewLimit), in.Limit)
+	assert.Equal(t, models.SortRecent, in.SortBy)
+
+	in = &pb.ListDestinationReviewsRequest{Page: 2, Limit: 5, SortBy: "Helpful"}
+	assert.NoError(t, normalizeReviewList(in))
+	assert.Equal(t, models.SortHelpful, in.SortBy)
+
+	assert.Error(t, normalizeReviewList(&pb.ListDestinationReviewsRequest{SortBy: "oldest"}))
+	assert.Error(t, normalizeReviewList(&pb.ListDestinationReviewsRequest{Limit: maxReviewLimit + 1}))
+	assert.Error(t, normalizeReviewList(&pb.ListDestinationReviewsRequest{Page: -1}))
+}
@@ -0,0 +1,496 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	routeGPX     = "gpx"
+	routeKML     = "kml"
+	routeGeoJSON = "geojson"
+
+	gpxNamespace = "http://www.topografix.com/GPX/1/1"
+	kmlNamespace = "http://www.opengis.net/kml/2.2"
+	gpxCreator   = "Travel Tales"
+)
+
+func (s *ItineraryService) SetDestinationCoordinates(ctx context.Context, in *pb.SetDestinationCoordinatesRequest) (*pb.SetDestinationCoordinatesResponse, error) {
+	if err := validateGeoPoint(in.Coordinates); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	destination, err := s.ItineraryRepo.GetItineraryDestination(in.DestinationId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzilini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err := s.authorize(destination.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	if err := s.ItineraryRepo.SetDestinationCoordinates(destination.ID, models.GeoPointFromPb(in.Coordinates)); err != nil {
+		s.Logger.Error("Xatolik sayohat manzilining koordinatalarini saqlashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.SetDestinationCoordinatesResponse{
+		DestinationId: destination.ID,
+		Coordinates:   in.Coordinates,
+	}, nil
+}
+
+func (s *ItineraryService) ExportItineraryRoute(ctx context.Context, in *pb.ExportItineraryRouteRequest) (*pb.ExportItineraryRouteResponse, error) {
+	format := strings.ToLower(in.Format)
+
+	var render func(*pb.GetItineraryResponse) (string, error)
+	var contentType string
+	switch format {
+	case routeGPX:
+		render, contentType = renderRouteGPX, "application/gpx+xml"
+	case routeKML:
+		render, contentType = renderRouteKML, "application/vnd.google-earth.kml+xml"
+	case routeGeoJSON:
+		render, contentType = renderRouteGeoJSON, "application/geo+json"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown route format %q, expected gpx, kml or geojson", in.Format)
+	}
+
+	if err := s.authorizeRead(in.Id, in.UserId, in.ShareToken); err != nil {
+		return nil, err
+	}
+
+	itinerary, err := s.loadItinerary(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := render(itinerary)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat marshrutini eksport qilishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ExportItineraryRouteResponse{
+		FileName:    fmt.Sprintf("itinerary-%s.%s", itinerary.Id, format),
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
+
+// routeStop is a stay of the exported route together with its pinned
+// activities.
+type routeStop struct {
+	dest       *pb.Destination
+	activities []routeActivity
+}
+
+type routeActivity struct {
+	act  *pb.Activity
+	stop string
+	// at is the start of the activity in UTC, zero when it has no time.
+	at time.Time
+}
+
+// routeStops orders the stays of an itinerary by date and keeps the
+// activities that carry coordinates, in schedule order.
+func routeStops(it *pb.GetItineraryResponse) ([]routeStop, error) {
+	dests := append([]*pb.Destination(nil), it.Destinations...)
+	sort.SliceStable(dests, func(i, j int) bool {
+		if dests[i].StartDate != dests[j].StartDate {
+			return dests[i].StartDate < dests[j].StartDate
+		}
+		return dests[i].EndDate < dests[j].EndDate
+	})
+
+	stops := make([]routeStop, 0, len(dests))
+	for _, d := range dests {
+		stop := routeStop{dest: d}
+		for _, a := range d.Schedule {
+			if a.Coordinates == nil {
+				continue
+			}
+
+			ra := routeActivity{act: a, stop: d.Name}
+			if a.Day != "" && a.StartTime != "" {
+				loc, err := loadZone(a.TimeZone)
+				if err != nil {
+					return nil, err
+				}
+				start, _, err := activityInterval(activityFromPb(a), loc)
+				if err != nil {
+					return nil, err
+				}
+				ra.at = start.UTC()
+			}
+			stop.activities = append(stop.activities, ra)
+		}
+		stops = append(stops, stop)
+	}
+
+	return stops, nil
+}
+
+type gpxFile struct {
+	XMLName   xml.Name   `xml:"gpx"`
+	Version   string     `xml:"version,attr"`
+	Creator   string     `xml:"creator,attr"`
+	Xmlns     string     `xml:"xmlns,attr"`
+	Name      string     `xml:"metadata>name"`
+	Desc      string     `xml:"metadata>desc,omitempty"`
+	Waypoints []gpxPoint `xml:"wpt"`
+	Route     *gpxRoute  `xml:"rte,omitempty"`
+}
+
+type gpxRoute struct {
+	Name   string     `xml:"name"`
+	Points []gpxPoint `xml:"rtept"`
+}
+
+// gpxPoint keeps the coordinates as strings, GPX wants plain decimals and
+// encoding/xml would switch to exponents for values close to zero.
+type gpxPoint struct {
+	Lat  string `xml:"lat,attr"`
+	Lon  string `xml:"lon,attr"`
+	Time string `xml:"time,omitempty"`
+	Name string `xml:"name"`
+	Desc string `xml:"desc,omitempty"`
+	Type string `xml:"type,omitempty"`
+}
+
+// renderRouteGPX writes a GPX 1.1 file with a waypoint for every pinned stay
+// and activity and a route through the stays in date order.
+func renderRouteGPX(it *pb.GetItineraryResponse) (string, error) {
+	stops, err := routeStops(it)
+	if err != nil {
+		return "", err
+	}
+
+	doc := gpxFile{
+		Version: "1.1",
+		Creator: gpxCreator,
+		Xmlns:   gpxNamespace,
+		Name:    it.Title,
+		Desc:    it.Description,
+	}
+
+	route := &gpxRoute{Name: it.Title}
+	for _, stop := range stops {
+		if p := stop.dest.Coordinates; p != nil {
+			point := gpxPoint{
+				Lat:  formatCoordinate(p.Latitude),
+				Lon:  formatCoordinate(p.Longitude),
+				Name: stop.dest.Name,
+				Desc: stayDates(stop.dest),
+				Type: "stop",
+			}
+			doc.Waypoints = append(doc.Waypoints, point)
+			route.Points = append(route.Points, gpxPoint{Lat: point.Lat, Lon: point.Lon, Name: point.Name})
+		}
+
+		for _, a := range stop.activities {
+			point := gpxPoint{
+				Lat:  formatCoordinate(a.act.Coordinates.Latitude),
+				Lon:  formatCoordinate(a.act.Coordinates.Longitude),
+				Name: a.act.Title,
+				Desc: activityDescription(a),
+				Type: "activity",
+			}
+			if !a.at.IsZero() {
+				point.Time = a.at.Format(time.RFC3339)
+			}
+			doc.Waypoints = append(doc.Waypoints, point)
+		}
+	}
+	if len(route.Points) > 0 {
+		doc.Route = route
+	}
+
+	return marshalXML(doc)
+}
+
+type kmlFile struct {
+	XMLName  xml.Name    `xml:"kml"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	Document kmlDocument `xml:"Document"`
+}
+
+type kmlDocument struct {
+	Name        string        `xml:"name"`
+	Description string        `xml:"description,omitempty"`
+	Folders     []kmlFolder   `xml:"Folder"`
+	Route       *kmlPlacemark `xml:"Placemark,omitempty"`
+}
+
+type kmlFolder struct {
+	Name       string         `xml:"name"`
+	Placemarks []kmlPlacemark `xml:"Placemark"`
+}
+
+type kmlPlacemark struct {
+	Name        string       `xml:"name"`
+	Description string       `xml:"description,omitempty"`
+	TimeSpan    *kmlTimeSpan `xml:"TimeSpan,omitempty"`
+	TimeStamp   string       `xml:"TimeStamp>when,omitempty"`
+	Point       *kmlGeometry `xml:"Point,omitempty"`
+	LineString  *kmlGeometry `xml:"LineString,omitempty"`
+}
+
+type kmlTimeSpan struct {
+	Begin string `xml:"begin,omitempty"`
+	End   string `xml:"end,omitempty"`
+}
+
+type kmlGeometry struct {
+	Tessellate  int    `xml:"tessellate,omitempty"`
+	Coordinates string `xml:"coordinates"`
+}
+
+// renderRouteKML writes a KML 2.2 document with the pinned stays and
+// activities in separate folders and the route between the stays as a line.
+func renderRouteKML(it *pb.GetItineraryResponse) (string, error) {
+	stops, err := routeStops(it)
+	if err != nil {
+		return "", err
+	}
+
+	stopFolder := kmlFolder{Name: "Stops"}
+	activityFolder := kmlFolder{Name: "Activities"}
+	var line []string
+
+	for _, stop := range stops {
+		if p := stop.dest.Coordinates; p != nil {
+			stopFolder.Placemarks = append(stopFolder.Placemarks, kmlPlacemark{
+				Name:        stop.dest.Name,
+				Description: stayDates(stop.dest),
+				TimeSpan:    &kmlTimeSpan{Begin: dayOf(stop.dest.StartDate), End: dayOf(stop.dest.EndDate)},
+				Point:       &kmlGeometry{Coordinates: kmlCoordinate(p)},
+			})
+			line = append(line, kmlCoordinate(p))
+		}
+
+		for _, a := range stop.activities {
+			placemark := kmlPlacemark{
+				Name:        a.act.Title,
+				Description: activityDescription(a),
+				Point:       &kmlGeometry{Coordinates: kmlCoordinate(a.act.Coordinates)},
+			}
+			if !a.at.IsZero() {
+				placemark.TimeStamp = a.at.Format(time.RFC3339)
+			}
+			activityFolder.Placemarks = append(activityFolder.Placemarks, placemark)
+		}
+	}
+
+	doc := kmlFile{
+		Xmlns: kmlNamespace,
+		Document: kmlDocument{
+			Name:        it.Title,
+			Description: it.Description,
+			Folders:     []kmlFolder{stopFolder, activityFolder},
+		},
+	}
+	if len(line) > 1 {
+		doc.Document.Route = &kmlPlacemark{
+			Name:       "Route",
+			LineString: &kmlGeometry{Tessellate: 1, Coordinates: strings.Join(line, " ")},
+		}
+	}
+
+	return marshalXML(doc)
+}
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string          `json:"type"`
+	Geometry   geoJSONGeometry `json:"geometry"`
+	Properties map[string]any  `json:"properties"`
+}
+
+type geoJSONGeometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+// renderRouteGeoJSON writes an RFC 7946 feature collection: a point for every
+// pinned stay and activity and a line string for the route between the stays.
+// GeoJSON puts the longitude first.
+func renderRouteGeoJSON(it *pb.GetItineraryResponse) (string, error) {
+	stops, err := routeStops(it)
+	if err != nil {
+		return "", err
+	}
+
+	collection := geoJSONFeatureCollection{Type: "FeatureCollection", Features: []geoJSONFeature{}}
+	var line [][2]float64
+
+	for i, stop := range stops {
+		if p := stop.dest.Coordinates; p != nil {
+			collection.Features = append(collection.Features, geoJSONFeature{
+				Type:     "Feature",
+				Geometry: geoJSONGeometry{Type: "Point", Coordinates: [2]float64{p.Longitude, p.Latitude}},
+				Properties: map[string]any{
+					"kind":       "stop",
+					"id":         stop.dest.Id,
+					"name":       stop.dest.Name,
+					"start_date": dayOf(stop.dest.StartDate),
+					"end_date":   dayOf(stop.dest.EndDate),
+					"order":      i + 1,
+				},
+			})
+			line = append(line, [2]float64{p.Longitude, p.Latitude})
+		}
+
+		for _, a := range stop.activities {
+			props := map[string]any{
+				"kind": "activity",
+				"id":   a.act.Id,
+				"name": a.act.Title,
+				"stop": a.stop,
+			}
+			for key, value := range map[string]string{
+				"day":        a.act.Day,
+				"start_time": a.act.StartTime,
+				"end_time":   a.act.EndTime,
+				"time_zone":  a.act.TimeZone,
+				"location":   a.act.Location,
+				"notes":      a.act.Notes,
+			} {
+				if value != "" {
+					props[key] = value
+				}
+			}
+			if !a.at.IsZero() {
+				props["time"] = a.at.Format(time.RFC3339)
+			}
+
+			p := a.act.Coordinates
+			collection.Features = append(collection.Features, geoJSONFeature{
+				Type:       "Feature",
+				Geometry:   geoJSONGeometry{Type: "Point", Coordinates: [2]float64{p.Longitude, p.Latitude}},
+				Properties: props,
+			})
+		}
+	}
+
+	if len(line) > 1 {
+		collection.Features = append(collection.Features, geoJSONFeature{
+			Type:       "Feature",
+			Geometry:   geoJSONGeometry{Type: "LineString", Coordinates: line},
+			Properties: map[string]any{"kind": "route", "name": it.Title},
+		})
+	}
+
+	content, err := json.MarshalIndent(collection, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func marshalXML(v any) (string, error) {
+	content, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return xml.Header + string(content) + "\n", nil
+}
+
+func stayDates(d *pb.Destination) string {
+	return dayOf(d.StartDate) + " - " + dayOf(d.EndDate)
+}
+
+func activityDescription(a routeActivity) string {
+	var parts []string
+	if a.act.Day != "" {
+		when := a.act.Day
+		if a.act.StartTime != "" {
+			when += " " + a.act.StartTime
+			if a.act.EndTime != "" {
+				when += "-" + a.act.EndTime
+			}
+		}
+		parts = append(parts, when)
+	}
+	if a.act.Location != "" {
+		parts = append(parts, a.act.Location)
+	}
+	if a.act.Notes != "" {
+		parts = append(parts, a.act.Notes)
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+// dayOf cuts the date out of the timestamps the stays are stored with.
+func dayOf(s string) string {
+	if len(s) > len(dateLayout) {
+		return s[:len(dateLayout)]
+	}
+
+	return s
+}
+
+func kmlCoordinate(p *pb.GeoPoint) string {
+	return formatCoordinate(p.Longitude) + "," + formatCoordinate(p.Latitude)
+}
+
+func formatCoordinate(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func validateGeoPoint(p *pb.GeoPoint) error {
+	if p == nil {
+		return nil
+	}
+
+	return validateCoordinates(p.Latitude, p.Longitude)
+}
+
+func validateCoordinates(lat, lng float64) error {
+	// NaN fails every comparison, so the range checks alone let it through.
+	if math.IsNaN(lat) || math.IsNaN(lng) {
+		return errors.New("coordinates must be numbers")
+	}
+	if lat < -90 || lat > 90 {
+		return fmt.Errorf("latitude %v is outside -90..90", lat)
+	}
+	if lng < -180 || lng > 180 {
+		return fmt.Errorf("longitude %v is outside -180..180", lng)
+	}
+
+	return nil
+}
+
+func geoToPb(p *models.GeoPoint) *pb.GeoPoint {
+	if p == nil {
+		return nil
+	}
+
+	return &pb.GeoPoint{Latitude: p.Latitude, Longitude: p.Longitude}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"encoding/json"
+	"encoding/xml"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleRoute() *pb.GetItineraryResponse {
+	it := sampleItinerary()
+	it.Destinations[0].Coordinates = &pb.GeoPoint{Latitude: 38.7223, Longitude: -9.1393}
+	it.Destinations[0].Schedule[0].Coordinates = &pb.GeoPoint{Latitude: 38.7177, Longitude: -9.1360}
+
+	// Listed first but visited after Lisbon.
+	porto := &pb.Destination{
+		Id:          "d-2",
+		Name:        "Porto & Douro",
+		StartDate:   "2024-04-02",
+		EndDate:     "2024-04-04",
+		Coordinates: &pb.GeoPoint{Latitude: 41.1579, Longitude: -8.6291},
+	}
+	it.Destinations = append([]*pb.Destination{porto}, it.Destinations...)
+
+	return it
+}
+
+func TestRenderRouteGPX(t *testing.T) {
+	content, err := renderRouteGPX(sampleRoute())
+	assert.NoError(t, err)
+
+	var doc gpxFile
+	assert.NoError(t, xml.Unmarshal([]byte(content), &doc))
+
+	assert.Len(t, doc.Waypoints, 3)
+	assert.Equal(t, "Lisbon", doc.Waypoints[0].Name)
+	assert.Equal(t, "Tram 28", doc.Waypoints[1].Name)
+	// 10:00 in Lisbon is still winter time on March 30.
+	assert.Equal(t, "2024-03-30T10:00:00Z", doc.Waypoints[1].Time)
+	assert.Equal(t, "-9.136", doc.Waypoints[1].Lon)
+
+	assert.NotNil(t, doc.Route)
+	assert.Equal(t, "Lisbon", doc.Route.Points[0].Name)
+	assert.Equal(t, "Porto & Douro", doc.Route.Points[1].Name)
+	assert.Contains(t, content, "Porto &amp; Douro")
+}
+
+func TestRenderRouteKML(t *testing.T) {
+	content, err := renderRouteKML(sampleRoute())
+	assert.NoError(t, err)
+
+	var doc kmlFile
+	assert.NoError(t, xml.Unmarshal([]byte(content), &doc))
+
+	assert.Len(t, doc.Document.Folders, 2)
+	assert.Len(t, doc.Document.Folders[0].Placemarks, 2)
+	assert.Equal(t, "-9.1393,38.7223", doc.Document.Folders[0].Placemarks[0].Point.Coordinates)
+	assert.Len(t, doc.Document.Folders[1].Placemarks, 1)
+	assert.Equal(t, "-9.1393,38.7223 -8.6291,41.1579", doc.Document.Route.LineString.Coordinates)
+}
+
+func TestRenderRouteGeoJSON(t *testing.T) {
+	content, err := renderRouteGeoJSON(sampleRoute())
+	assert.NoError(t, err)
+
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]any `json:"properties"`
+		} `json:"features"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(content), &collection))
+
+	assert.Equal(t, "FeatureCollection", collection.Type)
+	assert.Len(t, collection.Features, 4)
+
+	first := collection.Features[0]
+	assert.Equal(t, "stop", first.Properties["kind"])
+	assert.Equal(t, "Lisbon", first.Properties["name"])
+	assert.JSONEq(t, "[-9.1393,38.7223]", string(first.Geometry.Coordinates))
+
+	route := collection.Features[3]
+	assert.Equal(t, "LineString", route.Geometry.Type)
+	assert.JSONEq(t, "[[-9.1393,38.7223],[-8.6291,41.1579]]", string(route.Geometry.Coordinates))
+}
+
+func TestValidateGeoPoint(t *testing.T) {
+	assert.NoError(t, validateGeoPoint(nil))
+	assert.NoError(t, validateGeoPoint(&pb.GeoPoint{Latitude: -90, Longitude: 180}))
+	assert.Error(t, validateGeoPoint(&pb.GeoPoint{Latitude: 91}))
+	assert.Error(t, validateGeoPoint(&pb.GeoPoint{Longitude: -180.5}))
+	assert.Error(t, validateGeoPoint(&pb.GeoPoint{Latitude: math.NaN()}))
+	assert.Error(t, validateGeoPoint(&pb.GeoPoint{Longitude: math.NaN()}))
+	assert.Error(t, validateGeoPoint(&pb.GeoPoint{Longitude: math.Inf(1)}))
+}
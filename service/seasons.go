@@ -0,0 +1,186 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMonthLimit = 20
+	maxMonthLimit     = 100
+)
+
+// SetDestinationSeasons replaces the months of a destination and derives its
+// best time to visit from the recommended ones. An empty list removes the
+// months and the derived best time to visit with them.
+func (s *DestinationService) SetDestinationSeasons(ctx context.Context, in *pb.SetDestinationSeasonsRequest) (*pb.SetDestinationSeasonsResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+	if err := normalizeSeasons(in.Seasons); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	summary := bestTimeToVisit(in.Seasons)
+	err := s.DestinationRepo.SaveDestinationSeasons(in.DestinationId, in.Seasons, summary)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili mavsumlarini saqlashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.SetDestinationSeasonsResponse{Seasons: in.Seasons, BestTimeToVisit: summary}, nil
+}
+
+func (s *DestinationService) ListDestinationSeasons(ctx context.Context, in *pb.ListDestinationSeasonsRequest) (*pb.ListDestinationSeasonsResponse, error) {
+	if err := s.checkDestination(in.DestinationId); err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.DestinationRepo.ListDestinationSeasons(in.DestinationId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili mavsumlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ListDestinationSeasonsResponse{Seasons: seasons}, nil
+}
+
+// ListDestinationsForMonth answers "where is good to go in March": the
+// destinations recommended in the month, optionally narrowed down by
+// temperature and crowds.
+func (s *DestinationService) ListDestinationsForMonth(ctx context.Context, in *pb.ListDestinationsForMonthRequest) (*pb.ListDestinationsForMonthResponse, error) {
+	if err := normalizeMonthSearch(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.DestinationRepo.ListDestinationsForMonth(in)
+	if err != nil {
+		s.Logger.Error("Xatolik oy bo'yicha sayohat manzillarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// normalizeSeasons lowercases the levels of the seasons, checks them and
+// sorts them by month. Every month appears at most once.
+func normalizeSeasons(seasons []*pb.DestinationSeason) error {
+	seen := map[int32]bool{}
+	for _, season := range seasons {
+		season.CrowdLevel = strings.ToLower(season.CrowdLevel)
+		season.TemperatureBand = strings.ToLower(season.TemperatureBand)
+
+		if season.Month < 1 || season.Month > 12 {
+			return errors.New("month must be between 1 and 12")
+		}
+		if seen[season.Month] {
+			return fmt.Errorf("%s is given more than once", time.Month(season.Month))
+		}
+		seen[season.Month] = true
+
+		if season.CrowdLevel != "" && !slices.Contains(models.CrowdLevels, season.CrowdLevel) {
+			return errors.New("crowd_level must be one of " + strings.Join(models.CrowdLevels, ", "))
+		}
+		if season.TemperatureBand != "" && !slices.Contains(models.TemperatureBands, season.TemperatureBand) {
+			return errors.New("temperature_band must be one of " + strings.Join(models.TemperatureBands, ", "))
+		}
+	}
+
+	sort.Slice(seasons, func(i, j int) bool { return seasons[i].Month < seasons[j].Month })
+
+	return nil
+}
+
+// normalizeMonthSearch fills in the defaults of a month search and checks
+// the rest of it.
+func normalizeMonthSearch(in *pb.ListDestinationsForMonthRequest) error {
+	in.TemperatureBand = strings.ToLower(in.TemperatureBand)
+	in.MaxCrowdLevel = strings.ToLower(in.MaxCrowdLevel)
+
+	if in.Month < 1 || in.Month > 12 {
+		return errors.New("month must be between 1 and 12")
+	}
+	if in.TemperatureBand != "" && !slices.Contains(models.TemperatureBands, in.TemperatureBand) {
+		return errors.New("temperature_band must be one of " + strings.Join(models.TemperatureBands, ", "))
+	}
+	if in.MaxCrowdLevel != "" && !slices.Contains(models.CrowdLevels, in.MaxCrowdLevel) {
+		return errors.New("max_crowd_level must be one of " + strings.Join(models.CrowdLevels, ", "))
+	}
+	if in.Page < 0 || in.Limit < 0 || in.Limit > maxMonthLimit {
+		return errors.New("page must be positive and limit between 1 and 100")
+	}
+	if in.Page == 0 {
+		in.Page = 1
+	}
+	if in.Limit == 0 {
+		in.Limit = defaultMonthLimit
+	}
+
+	return nil
+}
+
+// bestTimeToVisit summarizes the recommended months, e.g. "April–June,
+// September". Runs of months may wrap around the new year, as in
+// "December–February".
+func bestTimeToVisit(seasons []*pb.DestinationSeason) string {
+	var recommended [13]bool
+	count := 0
+	for _, season := range seasons {
+		if season.Recommended {
+			recommended[season.Month] = true
+			count++
+		}
+	}
+
+	switch count {
+	case 0:
+		return ""
+	case 12:
+		return "All year round"
+	}
+
+	// Walking the year from a month that is not recommended never splits a run.
+	start := 1
+	for recommended[start] {
+		start++
+	}
+
+	var runs []string
+	first, last := 0, 0
+	for i := 1; i <= 12; i++ {
+		month := (start-1+i)%12 + 1
+		if recommended[month] {
+			if first == 0 {
+				first = month
+			}
+			last = month
+			continue
+		}
+
+		if first != 0 {
+			run := time.Month(first).String()
+			if last != first {
+				run += "–" + time.Month(last).String()
+			}
+			runs = append(runs, run)
+			first = 0
+		}
+	}
+
+	return strings.Join(runs, ", ")
+}
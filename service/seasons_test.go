@@ -0,0 +1,55 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func recommendedMonths(months ...int32) []*pb.DestinationSeason {
+	var seasons []*pb.DestinationSeason
+	for _, m := range months {
+		seasons = append(seasons, &pb.DestinationSeason{Month: m, Recommended: true})
+	}
+	return seasons
+}
+
+func TestBestTimeToVisit(t *testing.T) {
+	assert.Equal(t, "", bestTimeToVisit(nil))
+	assert.Equal(t, "", bestTimeToVisit([]*pb.DestinationSeason{{Month: 3}}))
+	assert.Equal(t, "July", bestTimeToVisit(recommendedMonths(7)))
+	assert.Equal(t, "April–June, September", bestTimeToVisit(recommendedMonths(4, 5, 6, 9)))
+	assert.Equal(t, "June, December–February", bestTimeToVisit(recommendedMonths(12, 1, 2, 6)))
+	assert.Equal(t, "All year round", bestTimeToVisit(recommendedMonths(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)))
+}
+
+func TestNormalizeSeasons(t *testing.T) {
+	seasons := []*pb.DestinationSeason{
+		{Month: 8, CrowdLevel: "High", TemperatureBand: "HOT"},
+		{Month: 3, Recommended: true},
+	}
+	assert.NoError(t, normalizeSeasons(seasons))
+	assert.Equal(t, int32(3), seasons[0].Month)
+	assert.Equal(t, "high", seasons[1].CrowdLevel)
+	assert.Equal(t, "hot", seasons[1].TemperatureBand)
+
+	assert.Error(t, normalizeSeasons([]*pb.DestinationSeason{{Month: 0}}))
+	assert.Error(t, normalizeSeasons([]*pb.DestinationSeason{{Month: 13}}))
+	assert.Error(t, normalizeSeasons([]*pb.DestinationSeason{{Month: 5}, {Month: 5}}))
+	assert.Error(t, normalizeSeasons([]*pb.DestinationSeason{{Month: 5, CrowdLevel: "packed"}}))
+	assert.Error(t, normalizeSeasons([]*pb.DestinationSeason{{Month: 5, TemperatureBand: "tropical"}}))
+}
+
+func TestNormalizeMonthSearch(t *testing.T) {
+	in := &pb.ListDestinationsForMonthRequest{Month: 3, MaxCrowdLevel: "Medium"}
+	assert.NoError(t, normalizeMonthSearch(in))
+	assert.Equal(t, "medium", in.MaxCrowdLevel)
+	assert.Equal(t, int32(1), in.Page)
+	assert.Equal(t, int32(defaultMonthLimit), in.Limit)
+
+	assert.Error(t, normalizeMonthSearch(&pb.ListDestinationsForMonthRequest{}))
+	assert.Error(t, normalizeMonthSearch(&pb.ListDestinationsForMonthRequest{Month: 3, TemperatureBand: "freezing"}))
+	assert.Error(t, normalizeMonthSearch(&pb.ListDestinationsForMonthRequest{Month: 3, MaxCrowdLevel: "none"}))
+	assert.Error(t, normalizeMonthSearch(&pb.ListDestinationsForMonthRequest{Month: 3, Limit: maxMonthLimit + 1}))
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"content-service/currency"
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exactSettleLimit is the largest number of members with an open balance for
+// which the settlement searches for the minimal number of transfers. Larger
+// groups fall back to the greedy matching, which needs at most n-1.
+const exactSettleLimit = 15
+
+func (s *ItineraryService) GetSettlement(ctx context.Context, in *pb.GetSettlementRequest) (*pb.GetSettlementResponse, error) {
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	base, err := s.ItineraryRepo.GetItineraryCurrency(in.ItineraryId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat rejasining valyutasini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	expenses, err := s.ItineraryRepo.ListItineraryExpenses(in.ItineraryId, "")
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat xarajatlarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rates, err := s.RateRepo.GetLatestRates()
+	if err != nil {
+		s.Logger.Error("Xatolik valyuta kurslarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	paid, owed, rateDate, err := balances(expenses, currency.New(rates), base)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	resp := pb.GetSettlementResponse{Currency: base, RateDate: rateDate}
+
+	net := map[string]int64{}
+	for _, userId := range sortedKeys(union(paid, owed)) {
+		net[userId] = paid[userId] - owed[userId]
+		resp.Balances = append(resp.Balances, &pb.MemberBalance{
+			UserId: userId,
+			Paid:   fromCents(paid[userId]),
+			Owed:   fromCents(owed[userId]),
+			Net:    fromCents(net[userId]),
+		})
+	}
+
+	for _, t := range settle(net) {
+		resp.Transfers = append(resp.Transfers, &pb.Transfer{
+			FromUserId: t.from,
+			ToUserId:   t.to,
+			Amount:     fromCents(t.cents),
+		})
+	}
+
+	return &resp, nil
+}
+
+// validateSplit fills in the defaults of a shared expense and makes sure its
+// payer and participants are accepted members of the itinerary.
+func (s *ItineraryService) validateSplit(expense *models.ItineraryExpense) error {
+	if expense.PaidBy == "" {
+		expense.PaidBy = expense.CreatedBy
+	}
+
+	if len(expense.Participants) == 0 {
+		if expense.SplitType != "" && expense.SplitType != models.SplitNone {
+			return status.Error(codes.InvalidArgument, "participants are required to split an expense")
+		}
+		expense.SplitType = models.SplitNone
+		return nil
+	}
+	if expense.SplitType == "" {
+		expense.SplitType = models.SplitEqual
+	}
+
+	if _, err := splitExpense(expense.AmountCents, expense.SplitType, expense.Participants); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	members, err := s.ItineraryRepo.ListMembers(expense.ItineraryId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat rejasi a'zolarini olishda", slog.String("error", err.Error()))
+		return err
+	}
+
+	accepted := map[string]bool{}
+	for _, m := range members {
+		if m.Status == models.MemberAccepted {
+			accepted[m.UserId] = true
+		}
+	}
+
+	if !accepted[expense.PaidBy] {
+		return status.Error(codes.InvalidArgument, "payer is not a member of this itinerary")
+	}
+	for _, p := range expense.Participants {
+		if !accepted[p.UserId] {
+			return status.Errorf(codes.InvalidArgument, "participant %s is not a member of this itinerary", p.UserId)
+		}
+	}
+
+	return nil
+}
+
+// splitExpense divides amount between the participants. Cents that cannot be
+// divided evenly go to the participants with the largest remainders, so the
+// parts always add up to the amount.
+func splitExpense(amount int64, splitType string, participants []models.ExpenseShare) (map[string]int64, error) {
+	if len(participants) == 0 {
+		return nil, fmt.Errorf("expense has no participants")
+	}
+
+	weights := make([]int64, len(participants))
+	seen := map[string]bool{}
+	for i, p := range participants {
+		if p.UserId == "" || seen[p.UserId] {
+			return nil, fmt.Errorf("participants must be distinct users")
+		}
+		seen[p.UserId] = true
+
+		switch splitType {
+		case models.SplitEqual:
+			weights[i] = 1
+		case models.SplitShares:
+			if p.Shares <= 0 {
+				return nil, fmt.Errorf("shares of %s must be positive", p.UserId)
+			}
+			weights[i] = int64(p.Shares)
+		case models.SplitExact:
+			if p.AmountCents < 0 {
+				return nil, fmt.Errorf("amount of %s must not be negative", p.UserId)
+			}
+			weights[i] = p.AmountCents
+		default:
+			return nil, fmt.Errorf("unknown split type %q", splitType)
+		}
+	}
+
+	if splitType == models.SplitExact {
+		var sum int64
+		parts := map[string]int64{}
+		for i, p := range participants {
+			sum += weights[i]
+			parts[p.UserId] = weights[i]
+		}
+		if sum != amount {
+			return nil, fmt.Errorf("exact amounts add up to %.2f instead of %.2f", fromCents(sum), fromCents(amount))
+		}
+		return parts, nil
+	}
+
+	var total int64
+	for _, w := range weights {
+		total += w
+	}
+
+	type remainder struct {
+		index int
+		rest  int64
+	}
+
+	parts := map[string]int64{}
+	rests := make([]remainder, len(participants))
+	left := amount
+	for i, p := range participants {
+		part := amount * weights[i] / total
+		parts[p.UserId] = part
+		left -= part
+		rests[i] = remainder{index: i, rest: amount * weights[i] % total}
+	}
+
+	sort.SliceStable(rests, func(i, j int) bool {
+		if rests[i].rest != rests[j].rest {
+			return rests[i].rest > rests[j].rest
+		}
+		return participants[rests[i].index].UserId < participants[rests[j].index].UserId
+	})
+	for i := 0; left > 0; i++ {
+		parts[participants[rests[i%len(rests)].index].UserId]++
+		left--
+	}
+
+	return parts, nil
+}
+
+// balances works out how much every member paid and owes in the base
+// currency. Each share is converted separately and the payer is credited
+// with the sum of the converted shares, so the balances always add up to
+// zero. Personal expenses are left out.
+func balances(expenses []models.ItineraryExpense, rates *currency.Rates, base string) (map[string]int64, map[string]int64, string, error) {
+	paid := map[string]int64{}
+	owed := map[string]int64{}
+	var oldest string
+
+	for _, e := range expenses {
+		if e.SplitType == models.SplitNone || len(e.Participants) == 0 {
+			continue
+		}
+
+		parts, err := splitExpense(e.AmountCents, e.SplitType, e.Participants)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("expense %s: %w", e.ID, err)
+		}
+
+		for _, userId := range sortedKeys(parts) {
+			cents, date, err := rates.Convert(parts[userId], e.Currency, base)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			oldest = currency.OlderDate(oldest, date)
+
+			owed[userId] += cents
+			paid[e.PaidBy] += cents
+		}
+	}
+
+	return paid, owed, oldest, nil
+}
+
+type transfer struct {
+	from  string
+	to    string
+	cents int64
+}
+
+// settle turns net balances (positive means the member is owed money) into
+// transfers. Members are split into the largest possible number of groups
+// whose balances cancel out; every such group of k members is settled with
+// k-1 transfers, which gives the minimal number of transfers overall.
+func settle(net map[string]int64) []transfer {
+	var users []string
+	for _, userId := range sortedKeys(net) {
+		if net[userId] != 0 {
+			users = append(users, userId)
+		}
+	}
+
+	if len(users) > exactSettleLimit {
+		return sortTransfers(settleGreedy(users, net))
+	}
+
+	n := len(users)
+	full := 1<<n - 1
+
+	sum := make([]int64, full+1)
+	for mask := 1; mask <= full; mask++ {
+		low := mask & -mask
+		i := bitIndex(low)
+		sum[mask] = sum[mask^low] + net[users[i]]
+	}
+
+	// groups[mask] is the largest number of zero-sum groups the members in
+	// mask can be split into when mask itself sums to zero; prev remembers
+	// the member removed last to rebuild the groups.
+	groups := make([]int, full+1)
+	prev := make([]int, full+1)
+	for mask := 1; mask <= full; mask++ {
+		best, from := -1, 0
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 && groups[mask^(1<<i)] > best {
+				best, from = groups[mask^(1<<i)], i
+			}
+		}
+		groups[mask], prev[mask] = best, from
+		if sum[mask] == 0 {
+			groups[mask]++
+		}
+	}
+
+	var transfers []transfer
+	var group []string
+	for mask := full; mask != 0; {
+		i := prev[mask]
+		group = append(group, users[i])
+		mask ^= 1 << i
+
+		if sum[mask] == 0 {
+			transfers = append(transfers, settleGreedy(group, net)...)
+			group = nil
+		}
+	}
+
+	return sortTransfers(transfers)
+}
+
+// settleGreedy repeatedly lets the largest debtor pay the largest creditor.
+func settleGreedy(users []string, net map[string]int64) []transfer {
+	balance := map[string]int64{}
+	for _, userId := range users {
+		balance[userId] = net[userId]
+	}
+
+	var transfers []transfer
+	for {
+		var debtor, creditor string
+		for _, userId := range users {
+			if balance[userId] < 0 && (debtor == "" || balance[userId] < balance[debtor]) {
+				debtor = userId
+			}
+			if balance[userId] > 0 && (creditor == "" || balance[userId] > balance[creditor]) {
+				creditor = userId
+			}
+		}
+		if debtor == "" || creditor == "" {
+			break
+		}
+
+		cents := min(-balance[debtor], balance[creditor])
+		balance[debtor] += cents
+		balance[creditor] -= cents
+
+		transfers = append(transfers, transfer{from: debtor, to: creditor, cents: cents})
+	}
+
+	return transfers
+}
+
+func sortTransfers(transfers []transfer) []transfer {
+	sort.SliceStable(transfers, func(i, j int) bool {
+		if transfers[i].from != transfers[j].from {
+			return transfers[i].from < transfers[j].from
+		}
+		return transfers[i].to < transfers[j].to
+	})
+
+	return transfers
+}
+
+func bitIndex(bit int) int {
+	i := 0
+	for bit > 1 {
+		bit >>= 1
+		i++
+	}
+
+	return i
+}
+
+func union(a, b map[string]int64) map[string]bool {
+	keys := map[string]bool{}
+	for k := range a {
+		keys[k] = true
+	}
+	for k := range b {
+		keys[k] = true
+	}
+
+	return keys
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"content-service/currency"
+	"content-service/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitExpense(t *testing.T) {
+	people := []models.ExpenseShare{{UserId: "a"}, {UserId: "b"}, {UserId: "c"}}
+
+	parts, err := splitExpense(1000, models.SplitEqual, people)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a": 334, "b": 333, "c": 333}, parts)
+
+	parts, err = splitExpense(1000, models.SplitShares, []models.ExpenseShare{{UserId: "a", Shares: 2}, {UserId: "b", Shares: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a": 667, "b": 333}, parts)
+
+	parts, err = splitExpense(1000, models.SplitExact, []models.ExpenseShare{{UserId: "a", AmountCents: 250}, {UserId: "b", AmountCents: 750}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a": 250, "b": 750}, parts)
+
+	_, err = splitExpense(1000, models.SplitExact, []models.ExpenseShare{{UserId: "a", AmountCents: 250}})
+	assert.Error(t, err)
+
+	_, err = splitExpense(1000, models.SplitEqual, []models.ExpenseShare{{UserId: "a"}, {UserId: "a"}})
+	assert.Error(t, err)
+}
+
+func TestSettleFindsMinimalTransfers(t *testing.T) {
+	// a and b cancel out on their own, as do c, d and e. Greedy matching of
+	// the largest balances would need four transfers, the minimum is three.
+	net := map[string]int64{"a": 500, "b": -500, "c": 700, "d": -400, "e": -300, "f": 0}
+
+	transfers := settle(net)
+	assert.Len(t, transfers, 3)
+
+	balance := map[string]int64{}
+	for _, tr := range transfers {
+		assert.Positive(t, tr.cents)
+		balance[tr.from] += tr.cents
+		balance[tr.to] -= tr.cents
+	}
+	for userId, cents := range net {
+		assert.Equal(t, cents, -balance[userId], userId)
+	}
+}
+
+func TestBalancesInBaseCurrency(t *testing.T) {
+	rates := currency.New([]models.ExchangeRate{{Currency: "EUR", Rate: 0.5, RateDate: "2024-05-01"}})
+	expenses := []models.ItineraryExpense{
+		{ID: "e1", PaidBy: "a", AmountCents: 3000, Currency: "USD", SplitType: models.SplitEqual, Participants: []models.ExpenseShare{{UserId: "a"}, {UserId: "b"}, {UserId: "c"}}},
+		{ID: "e2", PaidBy: "b", AmountCents: 500, Currency: "EUR", SplitType: models.SplitExact, Participants: []models.ExpenseShare{{UserId: "c", AmountCents: 500}}},
+		{ID: "e3", PaidBy: "c", AmountCents: 9999, Currency: "USD", SplitType: models.SplitNone},
+	}
+
+	paid, owed, rateDate, err := balances(expenses, rates, "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-05-01", rateDate)
+	assert.Equal(t, map[string]int64{"a": 3000, "b": 1000}, paid)
+	assert.Equal(t, map[string]int64{"a": 1000, "b": 1000, "c": 2000}, owed)
+
+	transfers := settle(map[string]int64{"a": 2000, "b": 0, "c": -2000})
+	assert.Equal(t, []transfer{{from: "c", to: "a", cents: 2000}}, transfers)
+}
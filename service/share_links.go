@@ -0,0 +1,231 @@
+package service
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultShareLinkHours = 7 * 24
+	maxShareLinkHours     = 90 * 24
+)
+
+var errInvalidShareToken = errors.New("share link is invalid or has expired")
+
+func (s *ItineraryService) CreateItineraryShareLink(ctx context.Context, in *pb.CreateItineraryShareLinkRequest) (*pb.CreateItineraryShareLinkResponse, error) {
+	if len(s.ShareSecret) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "share links are not configured")
+	}
+	if in.ExpiresInHours < 0 || in.ExpiresInHours > maxShareLinkHours {
+		return nil, status.Errorf(codes.InvalidArgument, "expires_in_hours must be between 1 and %d", maxShareLinkHours)
+	}
+
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	hours := in.ExpiresInHours
+	if hours == 0 {
+		hours = defaultShareLinkHours
+	}
+	expiresAt := time.Now().UTC().Truncate(time.Second).Add(time.Duration(hours) * time.Hour)
+
+	link, err := s.ItineraryRepo.CreateShareLink(in.ItineraryId, in.UserId, expiresAt)
+	if err != nil {
+		s.Logger.Error("Xatolik ulashish havolasini yaratishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	link.Token = signShareToken(s.ShareSecret, link.Id, expiresAt)
+
+	return &pb.CreateItineraryShareLinkResponse{Link: link}, nil
+}
+
+func (s *ItineraryService) ListItineraryShareLinks(ctx context.Context, in *pb.ListItineraryShareLinksRequest) (*pb.ListItineraryShareLinksResponse, error) {
+	if _, err := s.authorize(in.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	links, err := s.ItineraryRepo.ListShareLinks(in.ItineraryId)
+	if err != nil {
+		s.Logger.Error("Xatolik ulashish havolalarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	for _, link := range links {
+		if err := s.fillShareToken(link); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListItineraryShareLinksResponse{Links: links}, nil
+}
+
+func (s *ItineraryService) RevokeShareLink(ctx context.Context, in *pb.RevokeShareLinkRequest) (*pb.RevokeShareLinkResponse, error) {
+	link, err := s.ItineraryRepo.GetShareLink(in.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "share link not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik ulashish havolasini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err := s.authorize(link.ItineraryId, in.UserId, models.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	err = s.ItineraryRepo.RevokeShareLink(in.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.FailedPrecondition, "share link is already revoked")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik ulashish havolasini bekor qilishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.RevokeShareLinkResponse{Message: "Share link revoked successfully"}, nil
+}
+
+// GetSharedItinerary serves an itinerary to anyone holding a working link,
+// no account needed. Every successful call counts as a view.
+func (s *ItineraryService) GetSharedItinerary(ctx context.Context, in *pb.GetSharedItineraryRequest) (*pb.GetSharedItineraryResponse, error) {
+	itineraryId, expiresAt, err := s.openShareLink(in.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	itinerary, err := s.loadItinerary(ctx, itineraryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSharedItineraryResponse{
+		Itinerary: itinerary,
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// openShareLink checks a share token, counts the visit and returns the
+// itinerary the link points to.
+func (s *ItineraryService) openShareLink(token string) (string, time.Time, error) {
+	if len(s.ShareSecret) == 0 {
+		return "", time.Time{}, status.Error(codes.FailedPrecondition, "share links are not configured")
+	}
+
+	linkId, expiresAt, err := parseShareToken(s.ShareSecret, token, time.Now())
+	if err != nil {
+		return "", time.Time{}, status.Error(codes.NotFound, err.Error())
+	}
+
+	itineraryId, err := s.ItineraryRepo.RecordShareLinkView(linkId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", time.Time{}, status.Error(codes.NotFound, errInvalidShareToken.Error())
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik ulashish havolasi ko'rilganini qayd etishda", slog.String("error", err.Error()))
+		return "", time.Time{}, err
+	}
+
+	return itineraryId, expiresAt, nil
+}
+
+// authorizeRead lets members with at least the viewer role read an
+// itinerary, and anyone holding a working share link of it.
+func (s *ItineraryService) authorizeRead(itineraryId, userId, shareToken string) error {
+	if shareToken == "" {
+		_, err := s.authorize(itineraryId, userId, models.RoleViewer)
+		return err
+	}
+
+	sharedId, _, err := s.openShareLink(shareToken)
+	if err != nil {
+		return err
+	}
+	if sharedId != itineraryId {
+		return status.Error(codes.PermissionDenied, "share link is for another itinerary")
+	}
+
+	return nil
+}
+
+// fillShareToken signs the token of a stored link again, which gives the
+// same token that was handed out when the link was created.
+func (s *ItineraryService) fillShareToken(link *pb.ShareLink) error {
+	if len(s.ShareSecret) == 0 {
+		return nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, link.ExpiresAt)
+	if err != nil {
+		s.Logger.Error("Xatolik ulashish havolasining muddatini o'qishda", slog.String("error", err.Error()))
+		return err
+	}
+	link.Token = signShareToken(s.ShareSecret, link.Id, expiresAt)
+
+	return nil
+}
+
+// signShareToken builds "<payload>.<signature>", both base64url encoded,
+// where the payload names the link and its expiry and the signature is an
+// HMAC-SHA256 of the payload.
+func signShareToken(secret []byte, linkId string, expiresAt time.Time) string {
+	payload := linkId + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(shareSignature(secret, payload))
+}
+
+// parseShareToken checks the signature and expiry of a token and returns the
+// link it names. Revocation is only known to the database.
+func parseShareToken(secret []byte, token string, now time.Time) (string, time.Time, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", time.Time{}, errInvalidShareToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", time.Time{}, errInvalidShareToken
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, shareSignature(secret, string(payload))) {
+		return "", time.Time{}, errInvalidShareToken
+	}
+
+	linkId, expiry, ok := strings.Cut(string(payload), ".")
+	if !ok {
+		return "", time.Time{}, errInvalidShareToken
+	}
+	unix, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return "", time.Time{}, errInvalidShareToken
+	}
+
+	expiresAt := time.Unix(unix, 0)
+	if !now.Before(expiresAt) {
+		return "", time.Time{}, fmt.Errorf("share link expired at %s", expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return linkId, expiresAt, nil
+}
+
+func shareSignature(secret []byte, payload string) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(payload))
+
+	return h.Sum(nil)
+}
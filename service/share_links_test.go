@@ -0,0 +1,42 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShareToken(t *testing.T) {
+	secret := []byte("test secret")
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(48 * time.Hour)
+
+	token := signShareToken(secret, "3f1c9a52-8d3e-4d55-9b1e-2f8a6c1d7e40", expiresAt)
+	assert.NotContains(t, token, "/")
+	assert.NotContains(t, token, "+")
+
+	linkId, expiry, err := parseShareToken(secret, token, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "3f1c9a52-8d3e-4d55-9b1e-2f8a6c1d7e40", linkId)
+	assert.True(t, expiry.Equal(expiresAt))
+
+	_, _, err = parseShareToken(secret, token, expiresAt)
+	assert.Error(t, err)
+
+	_, _, err = parseShareToken([]byte("another secret"), token, now)
+	assert.ErrorIs(t, err, errInvalidShareToken)
+
+	// A token for a different link cannot be made by editing the payload.
+	payload, sig, _ := strings.Cut(token, ".")
+	other := signShareToken(secret, "00000000-0000-0000-0000-000000000000", expiresAt)
+	otherPayload, _, _ := strings.Cut(other, ".")
+	_, _, err = parseShareToken(secret, otherPayload+"."+sig, now)
+	assert.ErrorIs(t, err, errInvalidShareToken)
+
+	for _, bad := range []string{"", "nodot", payload + ".", "!!." + sig} {
+		_, _, err = parseShareToken(secret, bad, now)
+		assert.ErrorIs(t, err, errInvalidShareToken, bad)
+	}
+}
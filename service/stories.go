@@ -6,7 +6,13 @@ import (
 	"content-service/models"
 	"content-service/storage/postgres"
 	"context"
+	"database/sql"
+	"errors"
 	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TravelStoriesService struct {
@@ -17,6 +23,17 @@ type TravelStoriesService struct {
 }
 
 func (s *TravelStoriesService) CreateTravelStory(cxt context.Context, in *pb.CreateTravelStoryRequest) (*pb.CreateTravelStoryResponse, error) {
+	in.CountryCode = strings.ToUpper(in.CountryCode)
+	if in.CountryCode != "" && !isCountryCode(in.CountryCode) {
+		return nil, status.Error(codes.InvalidArgument, "country_code must be an ISO 3166-1 alpha-2 code")
+	}
+	if err := s.checkCountry(in.CountryCode); err != nil {
+		return nil, err
+	}
+	if in.VisitedOn != "" && !isDate(in.VisitedOn) {
+		return nil, status.Error(codes.InvalidArgument, "visited_on must be YYYY-MM-DD")
+	}
+
 	resp, err := s.StoriyRepo.CreateTravelStory(in)
 	if err != nil {
 		return nil, err
@@ -176,4 +193,20 @@ func (s *TravelStoriesService) CountStories(ctx context.Context, in *pb.CountSto
 	return &pb.CountStoriesResponse{
 		CountStories: int32(resp),
 	}, nil
-}
\ No newline at end of file
+}
+func (s *TravelStoriesService) checkCountry(code string) error {
+	if code == "" {
+		return nil
+	}
+
+	_, err := s.StoriyRepo.GetCountryName(code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.InvalidArgument, "unknown country code %q", code)
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik davlat kodini tekshirishda", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
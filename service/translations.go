@@ -0,0 +1,262 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxLocales bounds the fallback chain built from one accept-language header.
+const maxLocales = 10
+
+func (s *DestinationService) SetDestinationTranslation(ctx context.Context, in *pb.SetDestinationTranslationRequest) (*pb.SetDestinationTranslationResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	locale, ok := normalizeLocale(in.Locale)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "locale must look like uz or pt-BR")
+	}
+	in.Locale = locale
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" || len([]rune(in.Name)) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "name is required and must be at most 100 characters")
+	}
+
+	t, err := s.DestinationRepo.SaveDestinationTranslation(in)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "destination not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili tarjimasini saqlashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.SetDestinationTranslationResponse{Translation: t}, nil
+}
+
+func (s *DestinationService) DeleteDestinationTranslation(ctx context.Context, in *pb.DeleteDestinationTranslationRequest) (*pb.DeleteDestinationTranslationResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	locale, _ := normalizeLocale(in.Locale)
+	err := s.DestinationRepo.DeleteDestinationTranslation(in.DestinationId, locale)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "translation not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili tarjimasini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.DeleteDestinationTranslationResponse{Message: "Translation deleted successfully"}, nil
+}
+
+func (s *DestinationService) ListDestinationTranslations(ctx context.Context, in *pb.ListDestinationTranslationsRequest) (*pb.ListDestinationTranslationsResponse, error) {
+	translations, err := s.DestinationRepo.ListDestinationTranslations(in.DestinationId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili tarjimalarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	activities, err := s.DestinationRepo.ListActivityTranslations(in.DestinationId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili tarjimalarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	attractions, err := s.DestinationRepo.ListAttractionTranslations(in.DestinationId)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili tarjimalarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.ListDestinationTranslationsResponse{
+		Translations: translations,
+		Activities:   activities,
+		Attractions:  attractions,
+	}, nil
+}
+
+func (s *DestinationService) SetActivityTranslation(ctx context.Context, in *pb.SetDestinationItemTranslationRequest) (*pb.SetDestinationItemTranslationResponse, error) {
+	return s.setItemTranslation(ctx, in, s.DestinationRepo.SaveActivityTranslation)
+}
+
+func (s *DestinationService) DeleteActivityTranslation(ctx context.Context, in *pb.DeleteDestinationItemTranslationRequest) (*pb.DeleteDestinationItemTranslationResponse, error) {
+	return s.deleteItemTranslation(ctx, in, s.DestinationRepo.DeleteActivityTranslation)
+}
+
+func (s *DestinationService) SetAttractionTranslation(ctx context.Context, in *pb.SetDestinationItemTranslationRequest) (*pb.SetDestinationItemTranslationResponse, error) {
+	return s.setItemTranslation(ctx, in, s.DestinationRepo.SaveAttractionTranslation)
+}
+
+func (s *DestinationService) DeleteAttractionTranslation(ctx context.Context, in *pb.DeleteDestinationItemTranslationRequest) (*pb.DeleteDestinationItemTranslationResponse, error) {
+	return s.deleteItemTranslation(ctx, in, s.DestinationRepo.DeleteAttractionTranslation)
+}
+
+func (s *DestinationService) setItemTranslation(ctx context.Context, in *pb.SetDestinationItemTranslationRequest, save func(itemId, locale, text string) (*pb.DestinationItemTranslation, error)) (*pb.SetDestinationItemTranslationResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	locale, ok := normalizeLocale(in.Locale)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "locale must look like uz or pt-BR")
+	}
+	text := strings.TrimSpace(in.Text)
+	if text == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is required")
+	}
+
+	t, err := save(in.ItemId, locale, text)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili yozuvi tarjimasini saqlashda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.SetDestinationItemTranslationResponse{Translation: t}, nil
+}
+
+func (s *DestinationService) deleteItemTranslation(ctx context.Context, in *pb.DeleteDestinationItemTranslationRequest, remove func(itemId, locale string) error) (*pb.DeleteDestinationItemTranslationResponse, error) {
+	if err := s.requireAdmin(in.UserId); err != nil {
+		return nil, err
+	}
+
+	locale, _ := normalizeLocale(in.Locale)
+	err := remove(in.ItemId, locale)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "translation not found")
+	}
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili yozuvi tarjimasini o'chirishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &pb.DeleteDestinationItemTranslationResponse{Message: "Translation deleted successfully"}, nil
+}
+
+// destinationTranslations looks up the translations of the destinations
+// into the first of locales available. It returns nil when no locale is
+// asked for.
+func (s *DestinationService) destinationTranslations(ids, locales []string) (map[string]*pb.DestinationTranslation, error) {
+	if len(locales) == 0 || len(ids) == 0 {
+		return nil, nil
+	}
+
+	translations, err := s.DestinationRepo.GetDestinationTranslations(ids, locales)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat manzili tarjimalarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+// translate puts a translation over the catalog text. An empty translated
+// description keeps the catalog one.
+func translate(t *pb.DestinationTranslation, name, description *string) {
+	if t == nil {
+		return
+	}
+
+	*name = t.Name
+	if t.Description != "" {
+		*description = t.Description
+	}
+}
+
+// requestLocales is the locale fallback chain of the accept-language
+// metadata of a request. After the chain comes the untranslated catalog.
+func requestLocales(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return parseAcceptLanguage(strings.Join(md.Get("accept-language"), ","))
+}
+
+// parseAcceptLanguage turns an accept-language header into a fallback chain
+// of locales, most preferred first. Each regional locale is followed by its
+// language, so "pt-BR, en;q=0.8" gives pt-BR, pt, en. Malformed entries and
+// the wildcard are skipped.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		if locale, ok := normalizeLocale(tag); ok {
+			tags = append(tags, weighted{locale, q})
+		}
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	var chain []string
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		language, _, _ := strings.Cut(tag.locale, "-")
+		for _, locale := range []string{tag.locale, language} {
+			if !seen[locale] && len(chain) < maxLocales {
+				seen[locale] = true
+				chain = append(chain, locale)
+			}
+		}
+	}
+
+	return chain
+}
+
+// normalizeLocale turns a language tag such as "PT_br" into the form kept
+// in the database, pt-BR. Only a language, optionally with a region, is
+// supported.
+func normalizeLocale(tag string) (string, bool) {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	language, region, hasRegion := strings.Cut(tag, "-")
+
+	language = strings.ToLower(language)
+	if !isLanguageCode(language) {
+		return "", false
+	}
+	if !hasRegion {
+		return language, true
+	}
+
+	region = strings.ToUpper(region)
+	if !isCountryCode(region) {
+		return "", false
+	}
+
+	return language + "-" + region, true
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Empty(t, parseAcceptLanguage(""))
+	assert.Empty(t, parseAcceptLanguage("*"))
+	assert.Equal(t, []string{"uz"}, parseAcceptLanguage("uz"))
+	assert.Equal(t, []string{"pt-BR", "pt", "en"}, parseAcceptLanguage("pt-BR, en;q=0.8"))
+	assert.Equal(t, []string{"ru", "en-US", "en"}, parseAcceptLanguage("en-US;q=0.5, ru, en;q=0.4"))
+	assert.Equal(t, []string{"uz"}, parseAcceptLanguage("uz, de;q=0, fr;q=abc, english"))
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	for tag, want := range map[string]string{"uz": "uz", "EN": "en", "pt_br": "pt-BR", " de-AT ": "de-AT"} {
+		got, ok := normalizeLocale(tag)
+		assert.True(t, ok, tag)
+		assert.Equal(t, want, got)
+	}
+
+	for _, tag := range []string{"", "*", "eng", "es-419", "zh-Hant-TW"} {
+		_, ok := normalizeLocale(tag)
+		assert.False(t, ok, tag)
+	}
+}
+
+func TestRequestLocales(t *testing.T) {
+	assert.Empty(t, requestLocales(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "uz-UZ, ru;q=0.7"))
+	assert.Equal(t, []string{"uz-UZ", "uz", "ru"}, requestLocales(ctx))
+}
+
+func TestTranslate(t *testing.T) {
+	name, description := "Samarkand", "Silk Road city"
+
+	translate(nil, &name, &description)
+	assert.Equal(t, "Samarkand", name)
+
+	translate(&pb.DestinationTranslation{Name: "Samarqand"}, &name, &description)
+	assert.Equal(t, "Samarqand", name)
+	assert.Equal(t, "Silk Road city", description)
+
+	translate(&pb.DestinationTranslation{Name: "Самарканд", Description: "Город на Шёлковом пути"}, &name, &description)
+	assert.Equal(t, "Самарканд", name)
+	assert.Equal(t, "Город на Шёлковом пути", description)
+}
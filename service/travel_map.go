@@ -0,0 +1,198 @@
+package service
+
+import (
+	pb "content-service/generated/communication"
+	"content-service/models"
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTravelMap collects where a user has been, from the stops of finished
+// itineraries and from geotagged stories, per country and per catalog
+// destination.
+func (s *CommunicationService) GetTravelMap(ctx context.Context, in *pb.GetTravelMapRequest) (*pb.GetTravelMapResponse, error) {
+	if in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	home := strings.ToUpper(in.HomeCountryCode)
+	if home != "" && !isCountryCode(home) {
+		return nil, status.Error(codes.InvalidArgument, "home_country_code must be an ISO 3166-1 alpha-2 code")
+	}
+
+	stops, err := s.ItineraryRepo.ListVisitedStops(in.UserId, time.Now().UTC().Format(dateLayout))
+	if err != nil {
+		s.Logger.Error("Xatolik userning tashrif buyurgan joylarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	stories, err := s.StoryRepo.ListGeotaggedStories(in.UserId)
+	if err != nil {
+		s.Logger.Error("Xatolik userning joylashuvi belgilangan hikoyalarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	resp, err := buildTravelMap(stops, stories, home)
+	if err != nil {
+		s.Logger.Error("Xatolik sayohat xaritasini tuzishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+	resp.UserId = in.UserId
+
+	return resp, nil
+}
+
+// visits gathers the trips, stories, days and visit range of one place.
+type visits struct {
+	trips   map[string]bool
+	stories int32
+	days    map[string]bool
+	first   string
+	last    string
+}
+
+func (v *visits) see(from, to string) {
+	if v.first == "" || from < v.first {
+		v.first = from
+	}
+	if to > v.last {
+		v.last = to
+	}
+}
+
+// buildTravelMap aggregates stops and stories. A day spent in two countries,
+// e.g. when crossing a border, counts for both of them but only once towards
+// the days abroad.
+func buildTravelMap(stops []models.VisitedStop, stories []models.GeotaggedStory, home string) (*pb.GetTravelMapResponse, error) {
+	countries := map[string]*visits{}
+	countryNames := map[string]string{}
+	destinations := map[string]*visits{}
+	destinationNames := map[string]string{}
+	destinationCountries := map[string]string{}
+	abroad := map[string]bool{}
+
+	place := func(all map[string]*visits, key string) *visits {
+		if all[key] == nil {
+			all[key] = &visits{trips: map[string]bool{}, days: map[string]bool{}}
+		}
+		return all[key]
+	}
+
+	for _, stop := range stops {
+		days, err := daysBetween(stop.StartDate, stop.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		var touched []*visits
+		if stop.CountryCode != "" {
+			countryNames[stop.CountryCode] = stop.CountryName
+			touched = append(touched, place(countries, stop.CountryCode))
+			if stop.CountryCode != home {
+				for _, day := range days {
+					abroad[day] = true
+				}
+			}
+		}
+		if stop.DestinationId != "" {
+			destinationNames[stop.DestinationId] = stop.DestinationName
+			if destinationCountries[stop.DestinationId] == "" {
+				destinationCountries[stop.DestinationId] = stop.CountryCode
+			}
+			touched = append(touched, place(destinations, stop.DestinationId))
+		}
+
+		for _, v := range touched {
+			v.trips[stop.ItineraryId] = true
+			for _, day := range days {
+				v.days[day] = true
+			}
+			v.see(stop.StartDate, stop.EndDate)
+		}
+	}
+
+	for _, story := range stories {
+		if story.CountryCode != "" {
+			countryNames[story.CountryCode] = story.CountryName
+			v := place(countries, story.CountryCode)
+			v.stories++
+			v.see(story.VisitedOn, story.VisitedOn)
+		}
+		if story.DestinationId != "" {
+			destinationNames[story.DestinationId] = story.DestinationName
+			if destinationCountries[story.DestinationId] == "" {
+				destinationCountries[story.DestinationId] = story.CountryCode
+			}
+			v := place(destinations, story.DestinationId)
+			v.stories++
+			v.see(story.VisitedOn, story.VisitedOn)
+		}
+	}
+
+	resp := &pb.GetTravelMapResponse{
+		TotalCountries:  int32(len(countries)),
+		TotalDaysAbroad: int32(len(abroad)),
+	}
+
+	for _, code := range byFirstVisit(countries) {
+		v := countries[code]
+		resp.Countries = append(resp.Countries, &pb.CountryVisits{
+			CountryCode: code,
+			Name:        countryNames[code],
+			Trips:       int32(len(v.trips)),
+			Stories:     v.stories,
+			Days:        int32(len(v.days)),
+			FirstVisit:  v.first,
+			LastVisit:   v.last,
+		})
+	}
+
+	for _, id := range byFirstVisit(destinations) {
+		v := destinations[id]
+		resp.Destinations = append(resp.Destinations, &pb.DestinationVisits{
+			DestinationId: id,
+			Name:          destinationNames[id],
+			CountryCode:   destinationCountries[id],
+			Trips:         int32(len(v.trips)),
+			Stories:       v.stories,
+			Days:          int32(len(v.days)),
+			FirstVisit:    v.first,
+			LastVisit:     v.last,
+		})
+	}
+
+	return resp, nil
+}
+
+func byFirstVisit(places map[string]*visits) []string {
+	keys := sortedKeys(places)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return places[keys[i]].first < places[keys[j]].first
+	})
+
+	return keys
+}
+
+// daysBetween lists the dates from start to end, both included.
+func daysBetween(start, end string) ([]string, error) {
+	from, err := time.Parse(dateLayout, start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := time.Parse(dateLayout, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []string
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		days = append(days, day.Format(dateLayout))
+	}
+
+	return days, nil
+}
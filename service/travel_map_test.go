@@ -0,0 +1,46 @@
+package service
+
+import (
+	"content-service/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildTravelMap(t *testing.T) {
+	lisbon := "5b0a6f8e-3c1d-4f7a-9e2b-1a2b3c4d5e6f"
+	stops := []models.VisitedStop{
+		{ItineraryId: "it-1", CountryCode: "PT", CountryName: "Portugal", DestinationId: lisbon, DestinationName: "Lisbon", StartDate: "2023-05-01", EndDate: "2023-05-04"},
+		{ItineraryId: "it-1", CountryCode: "ES", CountryName: "Spain", StartDate: "2023-05-04", EndDate: "2023-05-06"},
+		{ItineraryId: "it-2", CountryCode: "PT", CountryName: "Portugal", DestinationId: lisbon, DestinationName: "Lisbon", StartDate: "2024-03-29", EndDate: "2024-03-30"},
+		{ItineraryId: "it-3", CountryCode: "UZ", CountryName: "Uzbekistan", StartDate: "2024-06-01", EndDate: "2024-06-03"},
+	}
+	stories := []models.GeotaggedStory{
+		{ID: "s-1", CountryCode: "PT", CountryName: "Portugal", DestinationId: lisbon, DestinationName: "Lisbon", VisitedOn: "2022-08-15"},
+		{ID: "s-2", CountryCode: "JP", CountryName: "Japan", VisitedOn: "2024-11-02"},
+	}
+
+	resp, err := buildTravelMap(stops, stories, "UZ")
+	assert.NoError(t, err)
+
+	// Home counts as a country visited, as in ListVisitedCountries.
+	assert.Equal(t, int32(4), resp.TotalCountries)
+	// May 1-6 (the 4th counted once) and March 29-30; Uzbekistan is home.
+	assert.Equal(t, int32(8), resp.TotalDaysAbroad)
+
+	assert.Len(t, resp.Countries, 4)
+	pt := resp.Countries[0]
+	assert.Equal(t, "PT", pt.CountryCode)
+	assert.Equal(t, int32(2), pt.Trips)
+	assert.Equal(t, int32(1), pt.Stories)
+	assert.Equal(t, int32(6), pt.Days)
+	assert.Equal(t, "2022-08-15", pt.FirstVisit)
+	assert.Equal(t, "2024-03-30", pt.LastVisit)
+	assert.Equal(t, "JP", resp.Countries[3].CountryCode)
+	assert.Equal(t, int32(0), resp.Countries[3].Days)
+
+	assert.Len(t, resp.Destinations, 1)
+	assert.Equal(t, "Lisbon", resp.Destinations[0].Name)
+	assert.Equal(t, "PT", resp.Destinations[0].CountryCode)
+	assert.Equal(t, int32(2), resp.Destinations[0].Trips)
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	pb "content-service/generated/destination"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultTrendingLimit = 10
+	maxTrendingLimit     = 100
+	trendingCacheTTL     = 10 * time.Minute
+
+	// trendingGenerationKey is part of every cached page. Bumping it when
+	// scores or the catalog change orphans all pages at once, the orphans
+	// expire with their TTL.
+	trendingGenerationKey = "trending_destinations:generation"
+)
+
+// GetTrendDestinations serves the most popular destinations from Redis when
+// it can. Concurrent misses for the same page share one database query.
+func (s *DestinationService) GetTrendDestinations(ctx context.Context, in *pb.GetTrendDestinationRequest) (*pb.GetTrendDestinationResponse, error) {
+	if in.Limit < 0 || in.Limit > maxTrendingLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxTrendingLimit)
+	}
+	limit := in.Limit
+	if limit == 0 {
+		limit = defaultTrendingLimit
+	}
+
+	key := s.trendingCacheKey(ctx, limit)
+	if resp := s.cachedTrending(ctx, key); resp != nil {
+		return resp, nil
+	}
+
+	flight := key
+	if flight == "" {
+		flight = fmt.Sprintf("limit=%d", limit)
+	}
+
+	v, err, _ := s.trending.Do(flight, func() (interface{}, error) {
+		resp, err := s.DestinationRepo.GetTrendingDestinations(int(limit))
+		if err != nil {
+			return nil, err
+		}
+
+		// The callers waiting on this flight may have different deadlines.
+		s.cacheTrending(context.WithoutCancel(ctx), key, resp)
+
+		return resp, nil
+	})
+	if err != nil {
+		s.Logger.Error("xatolik top sayohat manzillarini olishda", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return v.(*pb.GetTrendDestinationResponse), nil
+}
+
+// invalidateTrending drops every cached trending page. It is called after
+// anything that can change them.
+func (s *DestinationService) invalidateTrending(ctx context.Context) {
+	if s.RedisClient == nil {
+		return
+	}
+
+	if err := s.RedisClient.R.Incr(ctx, trendingGenerationKey).Err(); err != nil {
+		s.Logger.Error("Xatolik top sayohat manzillari cacheni tozalashda", slog.String("error", err.Error()))
+	}
+}
+
+// trendingCacheKey names the cached page of limit destinations, or is empty
+// when Redis cannot be used.
+func (s *DestinationService) trendingCacheKey(ctx context.Context, limit int32) string {
+	if s.RedisClient == nil {
+		return ""
+	}
+
+	generation, err := s.RedisClient.R.Get(ctx, trendingGenerationKey).Int64()
+	if err != nil && err != redis.Nil {
+		s.Logger.Error("xatolik top sayohat manzillarini olishda redisdan", slog.String("error", err.Error()))
+		return ""
+	}
+
+	return trendingCacheKey(generation, limit)
+}
+
+func trendingCacheKey(generation int64, limit int32) string {
+	return fmt.Sprintf("trending_destinations:%d:limit=%d", generation, limit)
+}
+
+// cachedTrending returns the cached page, or nil on a miss. Broken entries
+// count as misses and get overwritten.
+func (s *DestinationService) cachedTrending(ctx context.Context, key string) *pb.GetTrendDestinationResponse {
+	if key == "" {
+		return nil
+	}
+
+	data, err := s.RedisClient.R.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		s.Logger.Error("xatolik top sayohat manzillarini olishda redisdan", slog.String("error", err.Error()))
+		return nil
+	}
+
+	var resp pb.GetTrendDestinationResponse
+	if err := protojson.Unmarshal(data, &resp); err != nil {
+		s.Logger.Error("Xatolik cachedagi top sayohat manzillarini o'qishda", slog.String("error", err.Error()))
+		return nil
+	}
+
+	return &resp
+}
+
+func (s *DestinationService) cacheTrending(ctx context.Context, key string, resp *pb.GetTrendDestinationResponse) {
+	if key == "" {
+		return
+	}
+
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		s.Logger.Error("Xatolik top sayohat manzillarini cachega yozishda", slog.String("error", err.Error()))
+		return
+	}
+
+	if err := s.RedisClient.R.Set(ctx, key, data, trendingCacheTTL).Err(); err != nil {
+		s.Logger.Error("Xatolik top sayohat manzillarini cachega yozishda", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrendingCacheKey(t *testing.T) {
+	assert.Equal(t, "trending_destinations:0:limit=10", trendingCacheKey(0, 10))
+	assert.NotEqual(t, trendingCacheKey(3, 10), trendingCacheKey(3, 20))
+	assert.NotEqual(t, trendingCacheKey(3, 10), trendingCacheKey(4, 10))
+
+	// Without Redis nothing is cached.
+	s := &DestinationService{}
+	assert.Empty(t, s.trendingCacheKey(context.Background(), 10))
+	assert.Nil(t, s.cachedTrending(context.Background(), ""))
+}
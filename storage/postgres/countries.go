@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+)
+
+// visitedStops joins the stops of the finished trips of a user ($1 is the
+// user, $3 today) to the country they are in. A country set on the stop wins
+// over the one of the linked catalog destination.
+const visitedStops = `
+		FROM
+			itinerary_members m
+		JOIN
+			itineraries i ON i.id = m.itinerary_id
+		JOIN
+			itinerary_destinations i_d ON i_d.itinerary_id = i.id
+		LEFT JOIN
+			destinations d ON d.id = i_d.destination_id
+		JOIN
+			countries c ON c.code = COALESCE(i_d.country_code, d.country_code)
+		WHERE
+			m.user_id = $1 AND m.status = $2 AND i.deleted_at = 0 AND i.end_date < $3`
+
+func (repo *ItinerariesRepo) GetCountryName(code string) (string, error) {
+	return countryName(repo.DB, code)
+}
+
+func (repo *DestinationRepo) GetCountryName(code string) (string, error) {
+	return countryName(repo.DB, code)
+}
+
+func (repo *TravelStoriesRepo) GetCountryName(code string) (string, error) {
+	return countryName(repo.DB, code)
+}
+
+func countryName(q queryRower, code string) (string, error) {
+	var name string
+	err := q.QueryRow(`
+		SELECT
+			name
+		FROM
+			countries
+		WHERE
+			code = $1
+	`, code).Scan(&name)
+
+	return name, err
+}
+
+// SetDestinationCountry sets the country of a stop, an empty code clears it.
+func (repo *ItinerariesRepo) SetDestinationCountry(destinationId, code string) error {
+	res, err := repo.DB.Exec(`
+		UPDATE
+			itinerary_destinations
+		SET
+			country_code = NULLIF($2, '')
+		WHERE
+			id = $1
+	`, destinationId, code)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+// TotalCountriesVisited counts the distinct countries of the trips the user
+// has been on, as owner or accepted member, that ended before today.
+func (repo *ItinerariesRepo) TotalCountriesVisited(userId, today string) (int32, error) {
+	var total int32
+
+	err := repo.DB.QueryRow(`
+		SELECT
+			COUNT(DISTINCT c.code)`+visitedStops,
+		userId, models.MemberAccepted, today).Scan(&total)
+
+	return total, err
+}
+
+// ListVisitedCountries returns the countries of the finished trips of a user
+// in the order they were first visited.
+func (repo *ItinerariesRepo) ListVisitedCountries(userId, today string) ([]*pb.VisitedCountry, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			c.code,
+			c.name,
+			TO_CHAR(MIN(i_d.start_date), 'YYYY-MM-DD'),
+			TO_CHAR(MAX(i_d.end_date), 'YYYY-MM-DD'),
+			COUNT(DISTINCT i.id)`+visitedStops+`
+		GROUP BY
+			c.code, c.name
+		ORDER BY
+			MIN(i_d.start_date), c.code
+	`, userId, models.MemberAccepted, today)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var countries []*pb.VisitedCountry
+	for rows.Next() {
+		var country pb.VisitedCountry
+
+		err = rows.Scan(&country.CountryCode, &country.Name, &country.FirstVisit, &country.LastVisit, &country.Trips)
+		if err != nil {
+			return nil, err
+		}
+
+		countries = append(countries, &country)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return countries, nil
+}
+
+// ListVisitedStops returns the stops of the finished trips of a user that
+// can be placed on a map, i.e. have a country or a catalog destination.
+func (repo *ItinerariesRepo) ListVisitedStops(userId, today string) ([]models.VisitedStop, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			i.id,
+			COALESCE(c.code, ''),
+			COALESCE(c.name, ''),
+			COALESCE(d.id::TEXT, ''),
+			COALESCE(d.name, ''),
+			TO_CHAR(i_d.start_date, 'YYYY-MM-DD'),
+			TO_CHAR(i_d.end_date, 'YYYY-MM-DD')
+		FROM
+			itinerary_members m
+		JOIN
+			itineraries i ON i.id = m.itinerary_id
+		JOIN
+			itinerary_destinations i_d ON i_d.itinerary_id = i.id
+		LEFT JOIN
+			destinations d ON d.id = i_d.destination_id
+		LEFT JOIN
+			countries c ON c.code = COALESCE(i_d.country_code, d.country_code)
+		WHERE
+			m.user_id = $1 AND m.status = $2 AND i.deleted_at = 0 AND i.end_date < $3 AND
+			(c.code IS NOT NULL OR d.id IS NOT NULL)
+		ORDER BY
+			i_d.start_date
+	`, userId, models.MemberAccepted, today)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stops []models.VisitedStop
+	for rows.Next() {
+		var stop models.VisitedStop
+
+		err = rows.Scan(&stop.ItineraryId, &stop.CountryCode, &stop.CountryName, &stop.DestinationId, &stop.DestinationName, &stop.StartDate, &stop.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		stops = append(stops, stop)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stops, nil
+}
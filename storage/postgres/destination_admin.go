@@ -0,0 +1,234 @@
+package postgres
+
+import (
+	pb "content-service/generated/destination"
+	"database/sql"
+)
+
+// destinationItems names a table of short texts attached to a destination
+// and the table of their translations, which refers to an item by key.
+type destinationItems struct {
+	table        string
+	column       string
+	translations string
+	key          string
+}
+
+var (
+	destinationActivities = destinationItems{
+		table:        "destination_activities",
+		column:       "activity",
+		translations: "destination_activity_translations",
+		key:          "activity_id",
+	}
+	topAttractions = destinationItems{
+		table:        "top_attractions",
+		column:       "attraction",
+		translations: "top_attraction_translations",
+		key:          "attraction_id",
+	}
+)
+
+// UpdateDestination changes a live destination. Fields left empty or zero,
+// and coordinates left unset, keep the stored value. Its best_time_to_visit
+// is kept once it has seasons, because then it is derived from them.
+func (repo *DestinationRepo) UpdateDestination(req *pb.UpdateDestinationRequest) (*pb.UpdateDestinationResponse, error) {
+	var resp pb.UpdateDestinationResponse
+	var lat, lng sql.NullFloat64
+	latArg, lngArg := destinationGeoArgs(req.Coordinates)
+
+	err := repo.DB.QueryRow(`
+		UPDATE
+			destinations
+		SET
+			name = COALESCE(NULLIF($2, ''), name),
+			country = COALESCE(NULLIF($3, ''), country),
+			country_code = CASE
+				WHEN $4 <> '' THEN $4
+				WHEN $3 <> '' THEN (SELECT code FROM countries WHERE LOWER(name) = LOWER($3) OR code = UPPER($3) LIMIT 1)
+				ELSE country_code
+			END,
+			description = COALESCE(NULLIF($5, ''), description),
+			best_time_to_visit = CASE WHEN EXISTS (SELECT 1 FROM destination_seasons WHERE destination_id = $1) THEN best_time_to_visit ELSE COALESCE(NULLIF($6, ''), best_time_to_visit) END,
+			average_cost_per_day = COALESCE(NULLIF($7::DECIMAL, 0), average_cost_per_day),
+			currency = COALESCE(NULLIF($8, ''), currency),
+			language = COALESCE(NULLIF($9, ''), language),
+			latitude = CASE WHEN $15 THEN NULL ELSE COALESCE($10::FLOAT8, latitude) END,
+			longitude = CASE WHEN $15 THEN NULL ELSE COALESCE($11::FLOAT8, longitude) END,
+			kind = COALESCE(NULLIF($12, ''), kind),
+			parent_id = CASE WHEN $14 THEN NULL ELSE COALESCE(NULLIF($13, '')::UUID, parent_id) END,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			deleted_at = 0 AND id = $1
+		RETURNING
+			id,
+			name,
+			country,
+			COALESCE(country_code, ''),
+			COALESCE(description, ''),
+			COALESCE(best_time_to_visit, ''),
+			COALESCE(average_cost_per_day, 0),
+			COALESCE(currency, ''),
+			COALESCE(language, ''),
+			updated_at,
+			latitude,
+			longitude,
+			kind,
+			COALESCE(parent_id::TEXT, '')
+	`, req.Id, req.Name, req.Country, req.CountryCode, req.Description, req.BestTimeToVisit, req.AverageCostPerDay, req.Currency, req.Language, latArg, lngArg,
+		req.Kind, req.ParentId, req.ClearParent, req.ClearCoordinates).
+		Scan(&resp.Id, &resp.Name, &resp.Country, &resp.CountryCode, &resp.Description, &resp.BestTimeToVisit, &resp.AverageCostPerDay, &resp.Currency, &resp.Language, &resp.UpdatedAt, &lat, &lng,
+			&resp.Kind, &resp.ParentId)
+
+	if err != nil {
+		return nil, duplicateDestination(err)
+	}
+	resp.Coordinates = destinationPoint(lat, lng)
+
+	return &resp, nil
+}
+
+// DeleteDestination takes a destination out of the catalog. Itineraries and
+// stories linked to it keep the link; the destinations under it move up to
+// its parent.
+func (repo *DestinationRepo) DeleteDestination(id string) error {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+		UPDATE
+			destinations
+		SET
+			deleted_at = DATE_PART('epoch', CURRENT_TIMESTAMP)::INT
+		WHERE
+			deleted_at = 0 AND id = $1
+	`, id)
+
+	if err != nil {
+		return err
+	}
+	if err = expectAffected(res); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE
+			destinations
+		SET
+			parent_id = (SELECT parent_id FROM destinations WHERE id = $1)
+		WHERE
+			parent_id = $1
+	`, id)
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (repo *DestinationRepo) AddDestinationActivity(destinationId, text string) (*pb.DestinationItem, error) {
+	return addDestinationItem(repo.DB, destinationActivities, destinationId, text)
+}
+
+func (repo *DestinationRepo) DeleteDestinationActivity(id string) error {
+	return repo.deleteDestinationItem(destinationActivities, id)
+}
+
+func (repo *DestinationRepo) ListDestinationActivities(destinationId string) ([]*pb.DestinationItem, error) {
+	return repo.listDestinationItems(destinationActivities, destinationId)
+}
+
+func (repo *DestinationRepo) AddTopAttraction(destinationId, text string) (*pb.DestinationItem, error) {
+	return addDestinationItem(repo.DB, topAttractions, destinationId, text)
+}
+
+func (repo *DestinationRepo) DeleteTopAttraction(id string) error {
+	return repo.deleteDestinationItem(topAttractions, id)
+}
+
+func (repo *DestinationRepo) ListTopAttractions(destinationId string) ([]*pb.DestinationItem, error) {
+	return repo.listDestinationItems(topAttractions, destinationId)
+}
+
+// addDestinationItem attaches a text to a live destination. It returns
+// sql.ErrNoRows when the destination does not exist or has been deleted.
+func addDestinationItem(q queryRower, items destinationItems, destinationId, text string) (*pb.DestinationItem, error) {
+	var item pb.DestinationItem
+
+	err := q.QueryRow(`
+		INSERT INTO `+items.table+` (
+			destination_id,
+			`+items.column+`
+		)
+		SELECT
+			id,
+			$2
+		FROM
+			destinations
+		WHERE
+			deleted_at = 0 AND id = $1
+		RETURNING
+			id,
+			destination_id,
+			`+items.column,
+		destinationId, text).Scan(&item.Id, &item.DestinationId, &item.Text)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (repo *DestinationRepo) deleteDestinationItem(items destinationItems, id string) error {
+	res, err := repo.DB.Exec(`
+		DELETE FROM
+			`+items.table+`
+		WHERE
+			id = $1
+	`, id)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+func (repo *DestinationRepo) listDestinationItems(items destinationItems, destinationId string) ([]*pb.DestinationItem, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			id,
+			destination_id,
+			`+items.column+`
+		FROM
+			`+items.table+`
+		WHERE
+			destination_id = $1
+		ORDER BY
+			`+items.column, destinationId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*pb.DestinationItem
+	for rows.Next() {
+		var item pb.DestinationItem
+		if err = rows.Scan(&item.Id, &item.DestinationId, &item.Text); err != nil {
+			return nil, err
+		}
+		list = append(list, &item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	pb "content-service/generated/destination"
+	"database/sql"
+	"math"
+)
+
+// earthRadiusKm is the mean radius of the Earth.
+const earthRadiusKm = 6371.0088
+
+// ListNearbyDestinations returns the destinations within radiusKm of center,
+// nearest first. Distances are great-circle distances computed with the
+// haversine formula, so no PostGIS is needed; the latitude band the radius
+// spans is used to skip far away rows first.
+func (repo *DestinationRepo) ListNearbyDestinations(center *pb.GeoPoint, radiusKm float64, limit int32) ([]*pb.NearbyDestination, error) {
+	band := radiusKm / (earthRadiusKm * math.Pi / 180)
+
+	rows, err := repo.DB.Query(`
+		SELECT
+			id,
+			name,
+			country,
+			COALESCE(description, ''),
+			COALESCE(average_cost_per_day, 0),
+			COALESCE(currency, ''),
+			COALESCE(country_code, ''),
+			COALESCE(language, ''),
+			cost_band,
+			latitude,
+			longitude,
+			distance,`+reviewStats+`,
+			kind,
+			COALESCE(parent_id::TEXT, '')
+		FROM
+			(
+				SELECT
+					d.*,
+					2 * $2::FLOAT8 * ASIN(LEAST(1, SQRT(
+						POWER(SIN(RADIANS(d.latitude - $3) / 2), 2) +
+						COS(RADIANS($3)) * COS(RADIANS(d.latitude)) * POWER(SIN(RADIANS(d.longitude - $4) / 2), 2)
+					))) AS distance
+				FROM`+destinationSearch+`
+				WHERE
+					d.latitude BETWEEN $5 AND $6
+			) d`+reviewStatsJoin+`
+		WHERE
+			distance <= $7
+		ORDER BY
+			distance, name
+		LIMIT $8
+	`, "", earthRadiusKm, center.Latitude, center.Longitude, center.Latitude-band, center.Latitude+band, radiusKm, limit)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resp []*pb.NearbyDestination
+	for rows.Next() {
+		var dest pb.Destination
+		var lat, lng sql.NullFloat64
+		var distance float64
+
+		err = rows.Scan(&dest.Id, &dest.Name, &dest.Country, &dest.Description, &dest.AverageCostPerDay, &dest.Currency,
+			&dest.CountryCode, &dest.Language, &dest.CostBand, &lat, &lng, &distance,
+			&dest.AverageRating, &dest.ReviewCount, &dest.Kind, &dest.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		dest.Coordinates = destinationPoint(lat, lng)
+
+		resp = append(resp, &pb.NearbyDestination{Destination: &dest, DistanceKm: distance})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// destinationGeoArgs turns optional coordinates into query arguments, NULL
+// when unset.
+func destinationGeoArgs(p *pb.GeoPoint) (interface{}, interface{}) {
+	if p == nil {
+		return nil, nil
+	}
+
+	return p.Latitude, p.Longitude
+}
+
+func destinationPoint(lat, lng sql.NullFloat64) *pb.GeoPoint {
+	if !lat.Valid || !lng.Valid {
+		return nil
+	}
+
+	return &pb.GeoPoint{Latitude: lat.Float64, Longitude: lng.Float64}
+}
@@ -0,0 +1,242 @@
+package postgres
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"database/sql"
+	"fmt"
+)
+
+// maxDestinationDepth bounds walks up the hierarchy. Kinds only get
+// narrower going down, so real chains are at most as deep as there are
+// kinds.
+const maxDestinationDepth = 10
+
+// destinationSubtree selects the ids of the live destination id and of all
+// live destinations under it.
+func destinationSubtree(id string) string {
+	return `(
+				WITH RECURSIVE tree AS (
+					SELECT
+						id
+					FROM
+						destinations
+					WHERE
+						deleted_at = 0 AND id = ` + id + `
+					UNION ALL
+					SELECT
+						c.id
+					FROM
+						destinations c
+					JOIN
+						tree t ON c.parent_id = t.id
+					WHERE
+						c.deleted_at = 0
+				)
+				SELECT id FROM tree
+			)`
+}
+
+// destinationKind is the kind stored for a destination, city when unset.
+func destinationKind(kind string) string {
+	if kind == "" {
+		return models.KindCity
+	}
+
+	return kind
+}
+
+// GetDestinationPlace returns the kind, parent id and country of a live
+// destination. The parent id is empty at the top level.
+func (repo *DestinationRepo) GetDestinationPlace(id string) (string, string, string, error) {
+	var kind, parentId, country string
+
+	err := repo.DB.QueryRow(`
+		SELECT
+			kind,
+			COALESCE(parent_id::TEXT, ''),
+			country
+		FROM
+			destinations
+		WHERE
+			deleted_at = 0 AND id = $1
+	`, id).Scan(&kind, &parentId, &country)
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return kind, parentId, country, nil
+}
+
+// ListChildKinds returns the distinct kinds of the live destinations right
+// under a destination.
+func (repo *DestinationRepo) ListChildKinds(id string) ([]string, error) {
+	rows, err := repo.DB.Query(`
+		SELECT DISTINCT
+			kind
+		FROM
+			destinations
+		WHERE
+			deleted_at = 0 AND parent_id = $1
+	`, id)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var kinds []string
+	for rows.Next() {
+		var kind string
+		if err = rows.Scan(&kind); err != nil {
+			return nil, err
+		}
+		kinds = append(kinds, kind)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return kinds, nil
+}
+
+// ListBreadcrumbs returns the live destinations above a destination, the
+// broadest first. A deleted destination cuts the chain.
+func (repo *DestinationRepo) ListBreadcrumbs(id string) ([]*pb.Breadcrumb, error) {
+	rows, err := repo.DB.Query(`
+		WITH RECURSIVE chain AS (
+			SELECT
+				id,
+				name,
+				kind,
+				parent_id,
+				0 AS depth
+			FROM
+				destinations
+			WHERE
+				id = $1
+			UNION ALL
+			SELECT
+				p.id,
+				p.name,
+				p.kind,
+				p.parent_id,
+				c.depth + 1
+			FROM
+				destinations p
+			JOIN
+				chain c ON p.id = c.parent_id
+			WHERE
+				p.deleted_at = 0 AND c.depth < $2
+		)
+		SELECT
+			id,
+			name,
+			kind
+		FROM
+			chain
+		WHERE
+			depth > 0
+		ORDER BY
+			depth DESC
+	`, id, maxDestinationDepth)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var crumbs []*pb.Breadcrumb
+	for rows.Next() {
+		var crumb pb.Breadcrumb
+		if err = rows.Scan(&crumb.Id, &crumb.Name, &crumb.Kind); err != nil {
+			return nil, err
+		}
+		crumbs = append(crumbs, &crumb)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return crumbs, nil
+}
+
+// ListChildDestinations returns the live destinations right under a
+// destination, or at any depth under it when recursive, the most popular
+// first.
+func (repo *DestinationRepo) ListChildDestinations(req *pb.ListChildDestinationsRequest) (*pb.ListChildDestinationsResponse, error) {
+	args := []interface{}{"", req.DestinationId}
+
+	filter := " AND d.parent_id = $2"
+	if req.Recursive {
+		filter = " AND d.id IN " + destinationSubtree("$2") + " AND d.id <> $2"
+	}
+	if req.Kind != "" {
+		args = append(args, req.Kind)
+		filter += fmt.Sprintf(" AND d.kind = $%d", len(args))
+	}
+
+	ind := len(args) + 1
+	rows, err := repo.DB.Query(`
+		SELECT
+			id,
+			name,
+			country,
+			COALESCE(description, ''),
+			COALESCE(average_cost_per_day, 0),
+			COALESCE(currency, ''),
+			COALESCE(country_code, ''),
+			COALESCE(language, ''),
+			cost_band,
+			latitude,
+			longitude,`+reviewStats+`,
+			kind,
+			COALESCE(parent_id::TEXT, '')
+		FROM`+destinationSearch+reviewStatsJoin+`
+		WHERE
+			TRUE`+filter+`
+		ORDER BY
+			popularity_score DESC, name, id
+		`+fmt.Sprintf("OFFSET $%d LIMIT $%d", ind, ind+1),
+		append(args, (req.Page-1)*req.Limit, req.Limit)...)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := pb.ListChildDestinationsResponse{Page: req.Page, Limit: req.Limit}
+	for rows.Next() {
+		var dest pb.Destination
+		var lat, lng sql.NullFloat64
+
+		err = rows.Scan(&dest.Id, &dest.Name, &dest.Country, &dest.Description, &dest.AverageCostPerDay, &dest.Currency,
+			&dest.CountryCode, &dest.Language, &dest.CostBand, &lat, &lng, &dest.AverageRating, &dest.ReviewCount, &dest.Kind, &dest.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		dest.Coordinates = destinationPoint(lat, lng)
+
+		resp.Destinations = append(resp.Destinations, &dest)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = repo.DB.QueryRow(`
+		SELECT
+			COUNT(*)
+		FROM`+destinationSearch+`
+		WHERE
+			TRUE`+filter, args...).Scan(&resp.Total)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
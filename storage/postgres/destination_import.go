@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+// UpsertDestination saves an imported destination. A live destination with
+// the same name and country, compared case-insensitively, is updated and its
+// activities and top attractions are replaced; otherwise a new one is
+// created. An empty kind or parent keeps the stored one. The unique index on
+// name and country makes concurrent imports of the same destination update
+// one row. It reports whether a destination was created.
+func (repo *DestinationRepo) UpsertDestination(req *pb.AddDestinationRequest) (bool, error) {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	latArg, lngArg := destinationGeoArgs(req.Coordinates)
+
+	// xmax is only set on a row that existed and was updated.
+	var id string
+	var created bool
+	err = tx.QueryRow(`
+		INSERT INTO destinations (
+			name,
+			country,
+			description,
+			best_time_to_visit,
+			average_cost_per_day,
+			currency,
+			language,
+			country_code,
+			latitude,
+			longitude,
+			kind,
+			parent_id
+		)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5,
+			$6,
+			$7,
+			COALESCE(NULLIF($8, ''), (SELECT code FROM countries WHERE LOWER(name) = LOWER($2) OR code = UPPER($2) LIMIT 1)),
+			$9,
+			$10,
+			COALESCE(NULLIF($11, ''), 'city'),
+			NULLIF($12, '')::UUID
+		)
+		ON CONFLICT (LOWER(name), LOWER(country)) WHERE deleted_at = 0 DO UPDATE
+		SET
+			name = EXCLUDED.name,
+			country = EXCLUDED.country,
+			country_code = EXCLUDED.country_code,
+			description = EXCLUDED.description,
+			best_time_to_visit = CASE WHEN EXISTS (SELECT 1 FROM destination_seasons WHERE destination_id = destinations.id) THEN destinations.best_time_to_visit ELSE EXCLUDED.best_time_to_visit END,
+			average_cost_per_day = EXCLUDED.average_cost_per_day,
+			currency = EXCLUDED.currency,
+			language = EXCLUDED.language,
+			latitude = EXCLUDED.latitude,
+			longitude = EXCLUDED.longitude,
+			kind = COALESCE(NULLIF($11, ''), destinations.kind),
+			parent_id = COALESCE(NULLIF($12, '')::UUID, destinations.parent_id),
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING
+			id,
+			xmax = 0
+	`, req.Name, req.Country, req.Description, req.BestTimeToVisit, req.AverageCostPerDay, req.Currency, req.Language, req.CountryCode, latArg, lngArg,
+		req.Kind, req.ParentId).
+		Scan(&id, &created)
+
+	if err == nil && !created {
+		err = clearDestinationItems(tx, id)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, text := range req.Activities {
+		if _, err = addDestinationItem(tx, destinationActivities, id, text); err != nil {
+			return false, err
+		}
+	}
+	for _, text := range req.TopAttractions {
+		if _, err = addDestinationItem(tx, topAttractions, id, text); err != nil {
+			return false, err
+		}
+	}
+
+	return created, tx.Commit()
+}
+
+// FindDestination returns the id of the live destination with a name and
+// country, compared case-insensitively.
+func (repo *DestinationRepo) FindDestination(name, country string) (string, error) {
+	var id string
+	err := repo.DB.QueryRow(`
+		SELECT
+			id
+		FROM
+			destinations
+		WHERE
+			deleted_at = 0 AND LOWER(name) = LOWER($1) AND LOWER(country) = LOWER($2)
+	`, name, country).Scan(&id)
+
+	return id, err
+}
+
+func clearDestinationItems(tx *sql.Tx, destinationId string) error {
+	for _, items := range []destinationItems{destinationActivities, topAttractions} {
+		_, err := tx.Exec(`
+			DELETE FROM
+				`+items.table+`
+			WHERE
+				destination_id = $1
+		`, destinationId)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportDestinations calls fn with every live destination in the shape they
+// are imported in, the parent by name and country. Broader kinds come first
+// so parents are imported before what is under them, then country and name.
+func (repo *DestinationRepo) ExportDestinations(fn func(*pb.AddDestinationRequest) error) error {
+	rows, err := repo.DB.Query(`
+		SELECT
+			d.name,
+			d.country,
+			COALESCE(d.country_code, ''),
+			COALESCE(d.description, ''),
+			COALESCE(d.best_time_to_visit, ''),
+			COALESCE(d.average_cost_per_day, 0),
+			COALESCE(d.currency, ''),
+			COALESCE(d.language, ''),
+			d.latitude,
+			d.longitude,
+			ARRAY(SELECT activity FROM destination_activities WHERE destination_id = d.id ORDER BY activity),
+			ARRAY(SELECT attraction FROM top_attractions WHERE destination_id = d.id ORDER BY attraction),
+			d.kind,
+			COALESCE(p.name, ''),
+			COALESCE(p.country, '')
+		FROM
+			destinations d
+		LEFT JOIN
+			destinations p ON p.id = d.parent_id
+		WHERE
+			d.deleted_at = 0
+		ORDER BY
+			ARRAY_POSITION($1::TEXT[], d.kind::TEXT), d.country, d.name
+	`, pq.Array(models.DestinationKinds))
+
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d pb.AddDestinationRequest
+		var lat, lng sql.NullFloat64
+
+		err = rows.Scan(&d.Name, &d.Country, &d.CountryCode, &d.Description, &d.BestTimeToVisit, &d.AverageCostPerDay, &d.Currency, &d.Language,
+			&lat, &lng, pq.Array(&d.Activities), pq.Array(&d.TopAttractions), &d.Kind, &d.ParentName, &d.ParentCountry)
+		if err != nil {
+			return err
+		}
+		d.Coordinates = destinationPoint(lat, lng)
+
+		if err = fn(&d); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
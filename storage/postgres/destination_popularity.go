@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"content-service/models"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ListDestinationEngagement counts, per live destination, kind and day, the
+// stories about it, the likes and comments on those stories, the itineraries
+// it is a stop of and its bookmarks, starting from since.
+func (repo *DestinationRepo) ListDestinationEngagement(since time.Time) ([]models.Engagement, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			e.destination_id,
+			e.kind,
+			DATE_TRUNC('day', e.at),
+			COUNT(*)
+		FROM
+			(
+				SELECT
+					s.destination_id, $2::TEXT AS kind, s.created_at AS at
+				FROM
+					stories s
+				WHERE
+					s.deleted_at = 0
+				UNION ALL
+				SELECT
+					s.destination_id, $3, l.created_at
+				FROM
+					likes l
+				JOIN
+					stories s ON s.id = l.story_id AND s.deleted_at = 0
+				UNION ALL
+				SELECT
+					s.destination_id, $4, c.created_at
+				FROM
+					comments c
+				JOIN
+					stories s ON s.id = c.story_id AND s.deleted_at = 0
+				UNION ALL
+				SELECT
+					x.destination_id, $5, x.created_at
+				FROM
+					(
+						SELECT DISTINCT
+							i_d.destination_id, i.id, i.created_at
+						FROM
+							itinerary_destinations i_d
+						JOIN
+							itineraries i ON i.id = i_d.itinerary_id AND i.deleted_at = 0
+					) x
+				UNION ALL
+				SELECT
+					b.destination_id, $6, b.created_at
+				FROM
+					destination_bookmarks b
+			) e
+		JOIN
+			destinations d ON d.id = e.destination_id AND d.deleted_at = 0
+		WHERE
+			e.at >= $1
+		GROUP BY
+			e.destination_id, e.kind, DATE_TRUNC('day', e.at)
+	`, since, models.EngagementStory, models.EngagementLike, models.EngagementComment, models.EngagementItinerary, models.EngagementBookmark)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var engagement []models.Engagement
+	for rows.Next() {
+		var e models.Engagement
+		if err = rows.Scan(&e.DestinationId, &e.Kind, &e.Day, &e.Count); err != nil {
+			return nil, err
+		}
+		engagement = append(engagement, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return engagement, nil
+}
+
+// SaveDestinationScores stores freshly computed scores. Live destinations
+// missing from scores had no recent engagement and drop to zero.
+func (repo *DestinationRepo) SaveDestinationScores(scores []models.DestinationScore) error {
+	ids := make([]string, len(scores))
+	values := make([]int64, len(scores))
+	reasons := make([]string, len(scores))
+	for i, s := range scores {
+		ids[i], values[i], reasons[i] = s.DestinationId, int64(s.Score), s.TrendingReason
+	}
+
+	_, err := repo.DB.Exec(`
+		UPDATE
+			destinations d
+		SET
+			popularity_score = COALESCE(s.score, 0),
+			trending_reason = COALESCE(s.reason, ''),
+			popularity_updated_at = CURRENT_TIMESTAMP
+		FROM
+			destinations o
+		LEFT JOIN
+			UNNEST($1::UUID[], $2::INT[], $3::TEXT[]) AS s(id, score, reason) ON s.id = o.id
+		WHERE
+			d.id = o.id AND d.deleted_at = 0
+	`, pq.Array(ids), pq.Array(values), pq.Array(reasons))
+
+	return err
+}
+
+// BookmarkDestination saves a destination for a user, bookmarking it twice
+// changes nothing. A missing destination gives sql.ErrNoRows.
+func (repo *DestinationRepo) BookmarkDestination(userId, destinationId string) error {
+	var createdAt time.Time
+
+	return repo.DB.QueryRow(`
+		INSERT INTO destination_bookmarks (
+			user_id,
+			destination_id
+		)
+		SELECT
+			$1,
+			id
+		FROM
+			destinations
+		WHERE
+			deleted_at = 0 AND id = $2
+		ON CONFLICT (user_id, destination_id) DO UPDATE SET
+			created_at = destination_bookmarks.created_at
+		RETURNING
+			created_at
+	`, userId, destinationId).Scan(&createdAt)
+}
+
+func (repo *DestinationRepo) RemoveDestinationBookmark(userId, destinationId string) error {
+	res, err := repo.DB.Exec(`
+		DELETE FROM
+			destination_bookmarks
+		WHERE
+			user_id = $1 AND destination_id = $2
+	`, userId, destinationId)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
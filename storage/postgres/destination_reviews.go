@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"time"
+)
+
+const reviewColumns = `
+			id,
+			destination_id,
+			user_id,
+			rating,
+			content,
+			COALESCE(TO_CHAR(visit_month, 'YYYY-MM'), ''),
+			helpful_count,
+			created_at,
+			updated_at`
+
+// reviewStats selects the average rating and the review count of the
+// destination aliased d, rolled up over everything under it. The query
+// joins reviewStatsJoin for them.
+const reviewStats = `
+			rs.average_rating,
+			rs.review_count`
+
+// reviewStatsJoin walks the subtree of d once and aggregates its reviews.
+var reviewStatsJoin = `
+		CROSS JOIN LATERAL
+			(
+				SELECT
+					COALESCE(ROUND(AVG(r.rating), 2)::FLOAT8, 0) AS average_rating,
+					COUNT(*) AS review_count
+				FROM
+					destination_reviews r
+				WHERE
+					r.destination_id IN ` + destinationSubtree("d.id") + `
+			) rs`
+
+var reviewOrders = map[string]string{
+	models.SortRecent:  "created_at DESC, id",
+	models.SortHelpful: "helpful_count DESC, created_at DESC, id",
+	models.SortHighest: "rating DESC, created_at DESC, id",
+	models.SortLowest:  "rating, created_at DESC, id",
+}
+
+// CreateReview adds the review of a user. A user reviews a destination only
+// once, a second review gives sql.ErrNoRows.
+func (repo *DestinationRepo) CreateReview(req *pb.AddDestinationReviewRequest) (*pb.DestinationReview, error) {
+	return scanReview(repo.DB.QueryRow(`
+		INSERT INTO destination_reviews (
+			destination_id,
+			user_id,
+			rating,
+			content,
+			visit_month
+		)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			TO_DATE(NULLIF($5, ''), 'YYYY-MM')
+		)
+		ON CONFLICT (destination_id, user_id) DO NOTHING
+		RETURNING`+reviewColumns,
+		req.DestinationId, req.UserId, req.Rating, req.Content, req.VisitMonth))
+}
+
+// UpdateReview changes a review of the given author.
+func (repo *DestinationRepo) UpdateReview(req *pb.UpdateDestinationReviewRequest) (*pb.DestinationReview, error) {
+	return scanReview(repo.DB.QueryRow(`
+		UPDATE
+			destination_reviews
+		SET
+			rating = $3,
+			content = $4,
+			visit_month = TO_DATE(NULLIF($5, ''), 'YYYY-MM'),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			id = $1 AND user_id = $2
+		RETURNING`+reviewColumns,
+		req.Id, req.UserId, req.Rating, req.Content, req.VisitMonth))
+}
+
+func (repo *DestinationRepo) DeleteReview(id, userId string) error {
+	res, err := repo.DB.Exec(`
+		DELETE FROM
+			destination_reviews
+		WHERE
+			id = $1 AND user_id = $2
+	`, id, userId)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+func (repo *DestinationRepo) GetReview(id string) (*pb.DestinationReview, error) {
+	return scanReview(repo.DB.QueryRow(`
+		SELECT`+reviewColumns+`
+		FROM
+			destination_reviews
+		WHERE
+			id = $1
+	`, id))
+}
+
+// SetReviewVote adds or takes back the helpful vote of a user and returns
+// the new number of helpful votes. Voting twice counts once.
+func (repo *DestinationRepo) SetReviewVote(reviewId, userId string, helpful bool) (int32, error) {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if helpful {
+		_, err = tx.Exec(`
+			INSERT INTO destination_review_votes (
+				review_id,
+				user_id
+			)
+			VALUES (
+				$1,
+				$2
+			)
+			ON CONFLICT (review_id, user_id) DO NOTHING
+		`, reviewId, userId)
+	} else {
+		_, err = tx.Exec(`
+			DELETE FROM
+				destination_review_votes
+			WHERE
+				review_id = $1 AND user_id = $2
+		`, reviewId, userId)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var count int32
+	err = tx.QueryRow(`
+		UPDATE
+			destination_reviews
+		SET
+			helpful_count = (SELECT COUNT(*) FROM destination_review_votes WHERE review_id = $1)
+		WHERE
+			id = $1
+		RETURNING
+			helpful_count
+	`, reviewId).Scan(&count)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, tx.Commit()
+}
+
+// ListReviews returns a page of the reviews of a destination and of
+// everything under it, with the review count and average rating of all of
+// them, so they match the destination's own rating.
+func (repo *DestinationRepo) ListReviews(req *pb.ListDestinationReviewsRequest) (*pb.ListDestinationReviewsResponse, error) {
+	order, ok := reviewOrders[req.SortBy]
+	if !ok {
+		order = reviewOrders[models.SortRecent]
+	}
+
+	rows, err := repo.DB.Query(`
+		SELECT`+reviewColumns+`
+		FROM
+			destination_reviews
+		WHERE
+			destination_id IN `+destinationSubtree("$1")+`
+		ORDER BY
+			`+order+`
+		OFFSET $2 LIMIT $3
+	`, req.DestinationId, (req.Page-1)*req.Limit, req.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := pb.ListDestinationReviewsResponse{Page: req.Page, Limit: req.Limit}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		resp.Reviews = append(resp.Reviews, review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = repo.DB.QueryRow(`
+		SELECT
+			COALESCE(ROUND(AVG(rating), 2)::FLOAT8, 0),
+			COUNT(*)
+		FROM
+			destination_reviews
+		WHERE
+			destination_id IN `+destinationSubtree("$1")+`
+	`, req.DestinationId).Scan(&resp.AverageRating, &resp.Total)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func scanReview(row rowScanner) (*pb.DestinationReview, error) {
+	var review pb.DestinationReview
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(&review.Id, &review.DestinationId, &review.UserId, &review.Rating, &review.Content, &review.VisitMonth,
+		&review.HelpfulCount, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	review.CreatedAt = createdAt.UTC().Format(time.RFC3339)
+	review.UpdatedAt = updatedAt.UTC().Format(time.RFC3339)
+
+	return &review, nil
+}
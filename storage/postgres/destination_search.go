@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"content-service/currency"
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"database/sql"
+	"fmt"
+)
+
+// searchThreshold is the lowest word similarity a destination needs to match
+// a query. It lets "Pari" and "pariss" find Paris.
+const searchThreshold = 0.3
+
+// costBands are upper bounds, in the base currency, of the average cost per
+// day of each band. Destinations above the last bound are luxury.
+var costBands = []struct {
+	name  string
+	below float64
+}{
+	{models.CostBudget, 50},
+	{models.CostModerate, 150},
+	{models.CostExpensive, 300},
+}
+
+// destinationSearch lists live destinations with their cost band and their
+// relevance to the query, which is always $1. The cost band is empty when
+// the cost is unknown or has no exchange rate. With a query, only rows the
+// trigram indexes find through <% are scored, so it has to run in a
+// transaction from beginSearch.
+var destinationSearch = `
+		(
+			SELECT
+				d.*,
+				` + costBandColumn() + ` AS cost_band,
+				CASE WHEN $1::TEXT = '' THEN 0 ELSE GREATEST(
+					WORD_SIMILARITY($1, d.name),
+					WORD_SIMILARITY($1, d.country),
+					WORD_SIMILARITY($1, COALESCE(d.description, '')) * 0.8
+				) END AS relevance
+			FROM
+				destinations d
+			LEFT JOIN
+				(
+					SELECT DISTINCT ON (currency)
+						currency,
+						rate
+					FROM
+						exchange_rates
+					ORDER BY
+						currency, rate_date DESC
+				) r ON r.currency = d.currency
+			WHERE
+				d.deleted_at = 0 AND (
+					$1::TEXT = '' OR
+					$1 <% d.name OR
+					$1 <% d.country OR
+					$1 <% d.description
+				)
+		) d`
+
+// beginSearch starts the transaction a query search runs in. The <%
+// prefilter reads pg_trgm.word_similarity_threshold, which is set for the
+// transaction only, to the relevance cutoff; connection poolers reject it as
+// a startup parameter.
+func (repo *DestinationRepo) beginSearch() (*sql.Tx, error) {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		SELECT
+			SET_CONFIG('pg_trgm.word_similarity_threshold', $1, TRUE)
+	`, fmt.Sprintf("%g", searchThreshold))
+
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func costBandColumn() string {
+	cost := fmt.Sprintf("(d.average_cost_per_day / CASE WHEN d.currency = '%s' THEN 1 ELSE r.rate END)", currency.Base)
+
+	column := "CASE WHEN " + cost + " IS NULL THEN ''"
+	for _, band := range costBands {
+		column += fmt.Sprintf(" WHEN %s < %g THEN '%s'", cost, band.below, band.name)
+	}
+
+	return column + fmt.Sprintf(" ELSE '%s' END", models.CostLuxury)
+}
+
+// destinationFilter builds the conditions of a search over destinationSearch,
+// leaving out the filter named by skip. The returned arguments start with
+// the query.
+func destinationFilter(req *pb.ListDetinationRequest, skip string) (string, []interface{}) {
+	filter := ""
+	args := []interface{}{req.Query}
+
+	if req.Query != "" {
+		filter += fmt.Sprintf(" AND d.relevance >= %g", searchThreshold)
+	}
+	if req.Country != "" && skip != "country" {
+		args = append(args, req.Country)
+		filter += fmt.Sprintf(" AND (LOWER(d.country) = LOWER($%d) OR d.country_code = UPPER($%d))", len(args), len(args))
+	}
+	if req.Language != "" && skip != "language" {
+		args = append(args, req.Language)
+		filter += fmt.Sprintf(" AND LOWER(d.language) = LOWER($%d)", len(args))
+	}
+	if req.Currency != "" && skip != "currency" {
+		args = append(args, req.Currency)
+		filter += fmt.Sprintf(" AND d.currency = UPPER($%d)", len(args))
+	}
+	if req.CostBand != "" && skip != "cost_band" {
+		args = append(args, req.CostBand)
+		filter += fmt.Sprintf(" AND d.cost_band = $%d", len(args))
+	}
+	if req.WithinId != "" {
+		args = append(args, req.WithinId)
+		within := fmt.Sprintf("$%d", len(args))
+		filter += " AND d.id IN " + destinationSubtree(within) + " AND d.id <> " + within
+	}
+
+	return filter, args
+}
+
+func destinationFacets(tx *sql.Tx, req *pb.ListDetinationRequest) (*pb.DestinationFacets, error) {
+	var facets pb.DestinationFacets
+	columns := []struct {
+		name   string
+		column string
+		counts *[]*pb.Facet
+	}{
+		{"country", "d.country", &facets.Countries},
+		{"language", "d.language", &facets.Languages},
+		{"currency", "d.currency", &facets.Currencies},
+		{"cost_band", "d.cost_band", &facets.CostBands},
+	}
+
+	for _, facet := range columns {
+		filter, args := destinationFilter(req, facet.name)
+
+		rows, err := tx.Query(`
+			SELECT
+				`+facet.column+`,
+				COUNT(*)
+			FROM`+destinationSearch+`
+			WHERE
+				`+facet.column+` <> ''`+filter+`
+			GROUP BY
+				`+facet.column+`
+			ORDER BY
+				COUNT(*) DESC, `+facet.column, args...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			var f pb.Facet
+			if err = rows.Scan(&f.Value, &f.Count); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			*facet.counts = append(*facet.counts, &f)
+		}
+
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &facets, nil
+}
@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	pb "content-service/generated/destination"
+	"content-service/models"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// crowdRank orders crowd levels from the least crowded, unknown levels last.
+func crowdRank(column string) string {
+	levels := pq.QuoteLiteral("{" + strings.Join(models.CrowdLevels, ",") + "}")
+
+	return fmt.Sprintf("COALESCE(ARRAY_POSITION(%s::TEXT[], %s::TEXT), %d)", levels, column, len(models.CrowdLevels)+1)
+}
+
+// SaveDestinationSeasons replaces the months of a live destination and sets
+// best_time_to_visit to summary. Saving no months clears it, since the old
+// summary was derived from the months that are gone.
+func (repo *DestinationRepo) SaveDestinationSeasons(destinationId string, seasons []*pb.DestinationSeason, summary string) error {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+		UPDATE
+			destinations
+		SET
+			best_time_to_visit = $2,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			deleted_at = 0 AND id = $1
+	`, destinationId, summary)
+
+	if err != nil {
+		return err
+	}
+	if err = expectAffected(res); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM
+			destination_seasons
+		WHERE
+			destination_id = $1
+	`, destinationId)
+
+	if err != nil {
+		return err
+	}
+
+	months := make([]int64, len(seasons))
+	crowds := make([]string, len(seasons))
+	temperatures := make([]string, len(seasons))
+	recommended := make([]bool, len(seasons))
+	for i, season := range seasons {
+		months[i] = int64(season.Month)
+		crowds[i] = season.CrowdLevel
+		temperatures[i] = season.TemperatureBand
+		recommended[i] = season.Recommended
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO destination_seasons (
+			destination_id,
+			month,
+			crowd_level,
+			temperature_band,
+			recommended
+		)
+		SELECT
+			$1,
+			UNNEST($2::SMALLINT[]),
+			UNNEST($3::VARCHAR[]),
+			UNNEST($4::VARCHAR[]),
+			UNNEST($5::BOOLEAN[])
+	`, destinationId, pq.Array(months), pq.Array(crowds), pq.Array(temperatures), pq.Array(recommended))
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (repo *DestinationRepo) ListDestinationSeasons(destinationId string) ([]*pb.DestinationSeason, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			month,
+			crowd_level,
+			temperature_band,
+			recommended
+		FROM
+			destination_seasons
+		WHERE
+			destination_id = $1
+		ORDER BY
+			month
+	`, destinationId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seasons []*pb.DestinationSeason
+	for rows.Next() {
+		var season pb.DestinationSeason
+		if err = rows.Scan(&season.Month, &season.CrowdLevel, &season.TemperatureBand, &season.Recommended); err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, &season)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return seasons, nil
+}
+
+// ListDestinationsForMonth returns the destinations recommended in a month,
+// the least crowded and then the most popular first.
+func (repo *DestinationRepo) ListDestinationsForMonth(req *pb.ListDestinationsForMonthRequest) (*pb.ListDestinationsForMonthResponse, error) {
+	filter := ""
+	args := []interface{}{"", req.Month}
+
+	if req.TemperatureBand != "" {
+		args = append(args, req.TemperatureBand)
+		filter += fmt.Sprintf(" AND s.temperature_band = $%d", len(args))
+	}
+	if req.MaxCrowdLevel != "" {
+		args = append(args, req.MaxCrowdLevel)
+		filter += fmt.Sprintf(" AND %s <= %s", crowdRank("s.crowd_level"), crowdRank(fmt.Sprintf("$%d", len(args))))
+	}
+
+	from := `
+		FROM` + destinationSearch + `
+		JOIN
+			destination_seasons s ON s.destination_id = d.id`
+	where := `
+		WHERE
+			s.month = $2 AND s.recommended` + filter
+
+	ind := len(args) + 1
+	rows, err := repo.DB.Query(`
+		SELECT
+			d.id,
+			d.name,
+			d.country,
+			COALESCE(d.description, ''),
+			COALESCE(d.average_cost_per_day, 0),
+			COALESCE(d.currency, ''),
+			COALESCE(d.country_code, ''),
+			COALESCE(d.language, ''),
+			d.cost_band,
+			d.latitude,
+			d.longitude,`+reviewStats+`,
+			d.kind,
+			COALESCE(d.parent_id::TEXT, ''),
+			s.month,
+			s.crowd_level,
+			s.temperature_band,
+			s.recommended`+from+reviewStatsJoin+where+`
+		ORDER BY
+			`+crowdRank("s.crowd_level")+`, d.popularity_score DESC, d.name, d.id
+		`+fmt.Sprintf("OFFSET $%d LIMIT $%d", ind, ind+1),
+		append(args, (req.Page-1)*req.Limit, req.Limit)...)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := pb.ListDestinationsForMonthResponse{Page: req.Page, Limit: req.Limit}
+	for rows.Next() {
+		var dest pb.Destination
+		var season pb.DestinationSeason
+		var lat, lng sql.NullFloat64
+
+		err = rows.Scan(&dest.Id, &dest.Name, &dest.Country, &dest.Description, &dest.AverageCostPerDay, &dest.Currency,
+			&dest.CountryCode, &dest.Language, &dest.CostBand, &lat, &lng, &dest.AverageRating, &dest.ReviewCount, &dest.Kind, &dest.ParentId,
+			&season.Month, &season.CrowdLevel, &season.TemperatureBand, &season.Recommended)
+		if err != nil {
+			return nil, err
+		}
+		dest.Coordinates = destinationPoint(lat, lng)
+
+		resp.Destinations = append(resp.Destinations, &pb.DestinationForMonth{Destination: &dest, Season: &season})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = repo.DB.QueryRow(`
+		SELECT
+			COUNT(*)`+from+where, args...).Scan(&resp.Total)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
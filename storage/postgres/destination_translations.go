@@ -0,0 +1,304 @@
+package postgres
+
+import (
+	pb "content-service/generated/destination"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// GetDestinationTranslations returns, for each of the destinations that has
+// one, its translation into the first of locales available.
+func (repo *DestinationRepo) GetDestinationTranslations(ids, locales []string) (map[string]*pb.DestinationTranslation, error) {
+	rows, err := repo.DB.Query(`
+		SELECT DISTINCT ON (destination_id)
+			destination_id,
+			locale,
+			name,
+			description,
+			updated_at
+		FROM
+			destination_translations
+		WHERE
+			destination_id = ANY($1::UUID[]) AND locale = ANY($2::TEXT[])
+		ORDER BY
+			destination_id, ARRAY_POSITION($2::TEXT[], locale::TEXT)
+	`, pq.Array(ids), pq.Array(locales))
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	translations := map[string]*pb.DestinationTranslation{}
+	for rows.Next() {
+		var destinationId string
+		var updatedAt time.Time
+		var t pb.DestinationTranslation
+
+		if err = rows.Scan(&destinationId, &t.Locale, &t.Name, &t.Description, &updatedAt); err != nil {
+			return nil, err
+		}
+		t.UpdatedAt = updatedAt.UTC().Format(time.RFC3339)
+
+		translations[destinationId] = &t
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+// SaveDestinationTranslation adds or replaces the translation of a live
+// destination into one locale.
+func (repo *DestinationRepo) SaveDestinationTranslation(req *pb.SetDestinationTranslationRequest) (*pb.DestinationTranslation, error) {
+	var t pb.DestinationTranslation
+	var updatedAt time.Time
+
+	err := repo.DB.QueryRow(`
+		INSERT INTO destination_translations (
+			destination_id,
+			locale,
+			name,
+			description
+		)
+		SELECT
+			id,
+			$2,
+			$3,
+			$4
+		FROM
+			destinations
+		WHERE
+			deleted_at = 0 AND id = $1
+		ON CONFLICT (destination_id, locale) DO UPDATE SET
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING
+			locale,
+			name,
+			description,
+			updated_at
+	`, req.DestinationId, req.Locale, req.Name, req.Description).Scan(&t.Locale, &t.Name, &t.Description, &updatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+	t.UpdatedAt = updatedAt.UTC().Format(time.RFC3339)
+
+	return &t, nil
+}
+
+func (repo *DestinationRepo) DeleteDestinationTranslation(destinationId, locale string) error {
+	res, err := repo.DB.Exec(`
+		DELETE FROM
+			destination_translations
+		WHERE
+			destination_id = $1 AND locale = $2
+	`, destinationId, locale)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+func (repo *DestinationRepo) ListDestinationTranslations(destinationId string) ([]*pb.DestinationTranslation, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			locale,
+			name,
+			description,
+			updated_at
+		FROM
+			destination_translations
+		WHERE
+			destination_id = $1
+		ORDER BY
+			locale
+	`, destinationId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*pb.DestinationTranslation
+	for rows.Next() {
+		var t pb.DestinationTranslation
+		var updatedAt time.Time
+
+		if err = rows.Scan(&t.Locale, &t.Name, &t.Description, &updatedAt); err != nil {
+			return nil, err
+		}
+		t.UpdatedAt = updatedAt.UTC().Format(time.RFC3339)
+
+		list = append(list, &t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (repo *DestinationRepo) SaveActivityTranslation(itemId, locale, text string) (*pb.DestinationItemTranslation, error) {
+	return repo.saveItemTranslation(destinationActivities, itemId, locale, text)
+}
+
+func (repo *DestinationRepo) DeleteActivityTranslation(itemId, locale string) error {
+	return repo.deleteItemTranslation(destinationActivities, itemId, locale)
+}
+
+func (repo *DestinationRepo) ListActivityTranslations(destinationId string) ([]*pb.DestinationItemTranslation, error) {
+	return repo.listItemTranslations(destinationActivities, destinationId)
+}
+
+func (repo *DestinationRepo) SaveAttractionTranslation(itemId, locale, text string) (*pb.DestinationItemTranslation, error) {
+	return repo.saveItemTranslation(topAttractions, itemId, locale, text)
+}
+
+func (repo *DestinationRepo) DeleteAttractionTranslation(itemId, locale string) error {
+	return repo.deleteItemTranslation(topAttractions, itemId, locale)
+}
+
+func (repo *DestinationRepo) ListAttractionTranslations(destinationId string) ([]*pb.DestinationItemTranslation, error) {
+	return repo.listItemTranslations(topAttractions, destinationId)
+}
+
+// translatedItems returns the texts of a destination, each in the first of
+// locales it is translated into, or untranslated.
+func (repo *DestinationRepo) translatedItems(items destinationItems, destinationId string, locales []string) ([]string, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			COALESCE(
+				(
+					SELECT
+						t.`+items.column+`
+					FROM
+						`+items.translations+` t
+					WHERE
+						t.`+items.key+` = i.id AND t.locale = ANY($2::TEXT[])
+					ORDER BY
+						ARRAY_POSITION($2::TEXT[], t.locale::TEXT)
+					LIMIT 1
+				),
+				i.`+items.column+`
+			)
+		FROM
+			`+items.table+` i
+		WHERE
+			i.destination_id = $1
+	`, destinationId, pq.Array(locales))
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var texts []string
+	for rows.Next() {
+		var text string
+		if err = rows.Scan(&text); err != nil {
+			return nil, err
+		}
+		texts = append(texts, text)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return texts, nil
+}
+
+// saveItemTranslation adds or replaces the translation of an item into one
+// locale. It returns sql.ErrNoRows when the item does not exist.
+func (repo *DestinationRepo) saveItemTranslation(items destinationItems, itemId, locale, text string) (*pb.DestinationItemTranslation, error) {
+	var t pb.DestinationItemTranslation
+
+	err := repo.DB.QueryRow(`
+		INSERT INTO `+items.translations+` (
+			`+items.key+`,
+			locale,
+			`+items.column+`
+		)
+		SELECT
+			id,
+			$2,
+			$3
+		FROM
+			`+items.table+`
+		WHERE
+			id = $1
+		ON CONFLICT (`+items.key+`, locale) DO UPDATE SET
+			`+items.column+` = EXCLUDED.`+items.column+`
+		RETURNING
+			`+items.key+`,
+			locale,
+			`+items.column,
+		itemId, locale, text).Scan(&t.ItemId, &t.Locale, &t.Text)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (repo *DestinationRepo) deleteItemTranslation(items destinationItems, itemId, locale string) error {
+	res, err := repo.DB.Exec(`
+		DELETE FROM
+			`+items.translations+`
+		WHERE
+			`+items.key+` = $1 AND locale = $2
+	`, itemId, locale)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+func (repo *DestinationRepo) listItemTranslations(items destinationItems, destinationId string) ([]*pb.DestinationItemTranslation, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			t.`+items.key+`,
+			t.locale,
+			t.`+items.column+`
+		FROM
+			`+items.translations+` t
+		JOIN
+			`+items.table+` i ON i.id = t.`+items.key+`
+		WHERE
+			i.destination_id = $1
+		ORDER BY
+			t.`+items.key+`, t.locale
+	`, destinationId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*pb.DestinationItemTranslation
+	for rows.Next() {
+		var t pb.DestinationItemTranslation
+		if err = rows.Scan(&t.ItemId, &t.Locale, &t.Text); err != nil {
+			return nil, err
+		}
+		list = append(list, &t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -3,9 +3,16 @@ package postgres
 import (
 	pb "content-service/generated/destination"
 	"database/sql"
+	"errors"
 	"fmt"
+
+	"github.com/lib/pq"
 )
 
+// ErrDuplicateDestination is returned when a live destination with the same
+// name and country, compared case-insensitively, already exists.
+var ErrDuplicateDestination = errors.New("destination already exists")
+
 type DestinationRepo struct {
 	DB *sql.DB
 }
@@ -16,10 +23,32 @@ func NewDestinationRepo(db *sql.DB) *DestinationRepo {
 	}
 }
 
+// duplicateDestination turns a violation of the unique name and country
+// index into ErrDuplicateDestination.
+func duplicateDestination(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Constraint == "idx_destinations_name_country" {
+		return ErrDuplicateDestination
+	}
+
+	return err
+}
+
+// CreateDestination adds a catalog entry together with its activities and
+// top attractions. The country code is looked up from the country name when
+// it is not given.
 func (repo *DestinationRepo) CreateDestination(req *pb.AddDestinationRequest) (*pb.AddDestionationResponse, error) {
 	var resp pb.AddDestionationResponse
+	var lat, lng sql.NullFloat64
+	latArg, lngArg := destinationGeoArgs(req.Coordinates)
 
-	err := repo.DB.QueryRow(`
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(`
 		INSERT INTO destinations (
 			name,
 			country,
@@ -27,7 +56,12 @@ func (repo *DestinationRepo) CreateDestination(req *pb.AddDestinationRequest) (*
 			best_time_to_visit,
 			average_cost_per_day,
 			currency,
-			language
+			language,
+			country_code,
+			latitude,
+			longitude,
+			kind,
+			parent_id
 		)
 		VALUES (
 			$1,
@@ -36,62 +70,112 @@ func (repo *DestinationRepo) CreateDestination(req *pb.AddDestinationRequest) (*
 			$4,
 			$5,
 			$6,
-			$7
+			$7,
+			COALESCE(NULLIF($8, ''), (SELECT code FROM countries WHERE LOWER(name) = LOWER($2) OR code = UPPER($2) LIMIT 1)),
+			$9,
+			$10,
+			$11,
+			NULLIF($12, '')::UUID
 		)
 		RETURNING
 			id,
 			name,
 			country,
-			description,
-			best_time_to_visit,
-			average_cost_per_day,
-			currency,
-			language,
-			created_at
-	`, req.Name, req.Country, req.Description, req.BestTimeToVisit, req.AverageCostPerDay, req.Currency, req.Language).
-		Scan(&resp.Id, &resp.Name, &resp.Country, &resp.Description, &resp.BestTimeToVisit, &resp.AverageCostPerDay, &resp.Currency, &resp.Language, &resp.CreatedAt)
+			COALESCE(description, ''),
+			COALESCE(best_time_to_visit, ''),
+			COALESCE(average_cost_per_day, 0),
+			COALESCE(currency, ''),
+			COALESCE(language, ''),
+			created_at,
+			COALESCE(country_code, ''),
+			latitude,
+			longitude,
+			kind,
+			COALESCE(parent_id::TEXT, '')
+	`, req.Name, req.Country, req.Description, req.BestTimeToVisit, req.AverageCostPerDay, req.Currency, req.Language, req.CountryCode, latArg, lngArg,
+		destinationKind(req.Kind), req.ParentId).
+		Scan(&resp.Id, &resp.Name, &resp.Country, &resp.Description, &resp.BestTimeToVisit, &resp.AverageCostPerDay, &resp.Currency, &resp.Language, &resp.CreatedAt, &resp.CountryCode, &lat, &lng,
+			&resp.Kind, &resp.ParentId)
 
 	if err != nil {
+		return nil, duplicateDestination(err)
+	}
+	resp.Coordinates = destinationPoint(lat, lng)
+
+	for _, text := range req.Activities {
+		item, err := addDestinationItem(tx, destinationActivities, resp.Id, text)
+		if err != nil {
+			return nil, err
+		}
+		resp.Activities = append(resp.Activities, item)
+	}
+	for _, text := range req.TopAttractions {
+		item, err := addDestinationItem(tx, topAttractions, resp.Id, text)
+		if err != nil {
+			return nil, err
+		}
+		resp.TopAttractions = append(resp.TopAttractions, item)
+	}
+
+	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
+
 	return &resp, nil
 }
 
+// GetDestinations searches the catalog. The query is matched by trigram
+// word similarity, so case and small typos do not matter, and results are
+// ordered by how well they match. Facets count the destinations per value of
+// each filter while applying all the other filters.
 func (repo *DestinationRepo) GetDestinations(req *pb.ListDetinationRequest) (*pb.ListDetinationResponse, error) {
-	var resp []*pb.Destination
-	offset := (req.Page - 1) * req.Limit
-	var args []interface{}
-	ind := 1
-	query := `
+	tx, err := repo.beginSearch()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	filter, args := destinationFilter(req, "")
+	ind := len(args) + 1
+
+	rows, err := tx.Query(`
 		SELECT
 			id,
 			name,
 			country,
-			description
-		FROM
-			destinations
+			COALESCE(description, ''),
+			COALESCE(average_cost_per_day, 0),
+			COALESCE(currency, ''),
+			COALESCE(country_code, ''),
+			COALESCE(language, ''),
+			cost_band,
+			latitude,
+			longitude,`+reviewStats+`,
+			kind,
+			COALESCE(parent_id::TEXT, '')
+		FROM`+destinationSearch+reviewStatsJoin+`
 		WHERE
-			deleted_at = 0 `
-	if req.Query != "" {
-		query += fmt.Sprintf(" And name = $%d", ind)
-		ind++
-		args = append(args, req.Query)
-	}
-	query += fmt.Sprintf(" OFFSET $%d LIMIT $%d", ind, ind+1)
-	args = append(args, offset, req.Limit)
-
-	rows, err := repo.DB.Query(query, args...)
+			TRUE`+filter+`
+		ORDER BY
+			relevance DESC, popularity_score DESC, name, id
+		`+fmt.Sprintf("OFFSET $%d LIMIT $%d", ind, ind+1),
+		append(args, (req.Page-1)*req.Limit, req.Limit)...)
 
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
+	var resp []*pb.Destination
 	for rows.Next() {
 		var dest pb.Destination
-		err = rows.Scan(&dest.Id, &dest.Name, &dest.Country, &dest.Description)
+		var lat, lng sql.NullFloat64
+		err = rows.Scan(&dest.Id, &dest.Name, &dest.Country, &dest.Description, &dest.AverageCostPerDay, &dest.Currency,
+			&dest.CountryCode, &dest.Language, &dest.CostBand, &lat, &lng, &dest.AverageRating, &dest.ReviewCount, &dest.Kind, &dest.ParentId)
 		if err != nil {
 			return nil, err
 		}
+		dest.Coordinates = destinationPoint(lat, lng)
 
 		resp = append(resp, &dest)
 	}
@@ -101,29 +185,38 @@ func (repo *DestinationRepo) GetDestinations(req *pb.ListDetinationRequest) (*pb
 	}
 
 	var total int32
-	err = repo.DB.QueryRow(`
-		SELECT 
-			COUNT(*) 
-		FROM
-			destinations
+	err = tx.QueryRow(`
+		SELECT
+			COUNT(*)
+		FROM`+destinationSearch+`
 		WHERE
-			deleted_at = 0
-	`).Scan(&total)
+			TRUE`+filter, args...).Scan(&total)
 
 	if err != nil {
 		return nil, err
 	}
 
+	facets, err := destinationFacets(tx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return &pb.ListDetinationResponse{
 		Destinations: resp,
 		Total:        total,
 		Limit:        req.Limit,
 		Page:         req.Page,
+		Facets:       facets,
 	}, nil
 }
 
 func (repo *DestinationRepo) GetTravelDestination(id string) (*pb.GetDestinationResponse, error) {
 	var resp pb.GetDestinationResponse
+	var lat, lng sql.NullFloat64
 
 	err := repo.DB.QueryRow(`
 		SELECT
@@ -134,118 +227,80 @@ func (repo *DestinationRepo) GetTravelDestination(id string) (*pb.GetDestination
 			best_time_to_visit,
 			average_cost_per_day,
 			currency,
-			language
+			language,
+			latitude,
+			longitude,`+reviewStats+`,
+			kind,
+			COALESCE(parent_id::TEXT, '')
 		FROM
-			destinations
+			destinations d`+reviewStatsJoin+`
 		WHERE
-			deleted_at = 0 and id = $1
-	`, id).Scan(&resp.Id, &resp.Name, &resp.Country, &resp.Description, &resp.BestTimeToVisit, &resp.AverageCostPerDay, &resp.Currency, &resp.Language)
+			d.deleted_at = 0 and d.id = $1
+	`, id).Scan(&resp.Id, &resp.Name, &resp.Country, &resp.Description, &resp.BestTimeToVisit, &resp.AverageCostPerDay, &resp.Currency, &resp.Language, &lat, &lng,
+		&resp.AverageRating, &resp.ReviewCount, &resp.Kind, &resp.ParentId)
 
 	if err != nil {
 		return nil, err
 	}
+	resp.Coordinates = destinationPoint(lat, lng)
 
 	return &resp, nil
 }
 
-func (repo *DestinationRepo) GetDestinationActivities(id string) ([]string, error) {
-	var resp []string
+// GetDestinationActivities returns the activities of a destination, each in
+// the first of locales it is translated into.
+func (repo *DestinationRepo) GetDestinationActivities(id string, locales []string) ([]string, error) {
+	return repo.translatedItems(destinationActivities, id, locales)
+}
+
+// GetDestinationAttractions returns the top attractions of a destination,
+// each in the first of locales it is translated into.
+func (repo *DestinationRepo) GetDestinationAttractions(id string, locales []string) ([]string, error) {
+	return repo.translatedItems(topAttractions, id, locales)
+}
 
+// GetTrendingDestinations returns the most popular live destinations and how
+// many live destinations there are in total.
+func (repo *DestinationRepo) GetTrendingDestinations(limit int) (*pb.GetTrendDestinationResponse, error) {
 	rows, err := repo.DB.Query(`
 		SELECT
-			activity
+			id,
+			name,
+			country,
+			popularity_score,
+			trending_reason
 		FROM
-			destination_activities da
-		INNER JOIN
-			destinations d ON da.destination_id = d.id
+			destinations
 		WHERE
-			da.destination_id = $1
-	`, id)
-
-	if err != nil {
-		return nil, err
-	}
-
-	for rows.Next() {
-		var activity string
-
-		err = rows.Scan(&activity)
-		if err != nil {
-			return nil, err
-		}
-
-		resp = append(resp, activity)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return resp, nil
-}
-
-func (repo *DestinationRepo) GetDestinationAttractions(id string) ([]string, error) {
-	rows, err := repo.DB.Query(`
-        SELECT 
-            attraction 
-        FROM 
-            top_attractions
-        WHERE 
-            destination_id = $1
-    `, id)
+			deleted_at = 0
+		ORDER BY
+			popularity_score DESC, name, id
+		LIMIT $1
+	`, limit)
 
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var attractions []string
+	var destinations []*pb.TrendDestination
 	for rows.Next() {
-		var attraction string
-		if err := rows.Scan(&attraction); err != nil {
+		var dest pb.TrendDestination
+		if err := rows.Scan(&dest.Id, &dest.Name, &dest.Country, &dest.PopularityScore, &dest.TrendingReason); err != nil {
 			return nil, err
 		}
-		attractions = append(attractions, attraction)
+		destinations = append(destinations, &dest)
 	}
+
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return attractions, nil
-}
-
-func (repo *DestinationRepo) GetTrendingDestinations(limit int) (*pb.GetTrendDestinationResponse, error) {
-    rows, err := repo.DB.Query(`
-        SELECT 
-            id, 
-            name, 
-            country, 
-            popularity_score
-        FROM 
-            destinations 
-        ORDER BY 
-            popularity_score DESC 
-        LIMIT $1
-    `, limit)
-    if err != nil {
-        return nil, err
-    }
-    defer rows.Close()
-
-    var destinations []*pb.TrendDestination
-    for rows.Next() {
-        var dest pb.TrendDestination
-        if err := rows.Scan(&dest.Id, &dest.Name, &dest.Country, &dest.PopularityScore); err != nil {
-            return nil, err
-        }
-        destinations = append(destinations, &dest)
-    }
-
 	var total int32
 	err = repo.DB.QueryRow(`
-		SELECT 
-			COUNT(*) 
-		FROM 
+		SELECT
+			COUNT(*)
+		FROM
 			destinations
 		WHERE
 			deleted_at = 0
@@ -255,8 +310,8 @@ func (repo *DestinationRepo) GetTrendingDestinations(limit int) (*pb.GetTrendDes
 		return nil, err
 	}
 
-    return &pb.GetTrendDestinationResponse{
+	return &pb.GetTrendDestinationResponse{
 		Destinations: destinations,
-		Total: total,
+		Total:        total,
 	}, nil
-}
\ No newline at end of file
+}
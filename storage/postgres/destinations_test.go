@@ -2,7 +2,10 @@ package postgres
 
 import (
 	pb "content-service/generated/destination"
+	"content-service/models"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -48,7 +51,54 @@ func TestGetDestinations(t *testing.T) {
 	resp, err := repo.GetDestinations(req)
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, resp.Total, int32(2))
+	assert.GreaterOrEqual(t, resp.Total, int32(len(resp.Destinations)))
+	if assert.NotEmpty(t, resp.Destinations) {
+		assert.Equal(t, "Test Destination", resp.Destinations[0].Name)
+	}
+}
+
+func TestSearchDestinations(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	_, err = repo.UpsertDestination(&pb.AddDestinationRequest{
+		Name:              "Paris",
+		Country:           "France",
+		Description:       "Museums, cafes and the Eiffel Tower",
+		AverageCostPerDay: 120,
+		Currency:          "USD",
+		Language:          "fr",
+	})
+	assert.NoError(t, err)
+
+	for _, query := range []string{"paris", "Pari", "Parsi", "eiffel"} {
+		resp, err := repo.GetDestinations(&pb.ListDetinationRequest{Query: query, Page: 1, Limit: 10})
+		assert.NoError(t, err)
+		found := false
+		for _, d := range resp.Destinations {
+			found = found || d.Name == "Paris"
+		}
+		assert.True(t, found, query)
+	}
+
+	resp, err := repo.GetDestinations(&pb.ListDetinationRequest{Query: "paris", Country: "FR", CostBand: "moderate", Page: 1, Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(len(resp.Destinations)), resp.Total)
+	if assert.NotEmpty(t, resp.Destinations) {
+		assert.Equal(t, "moderate", resp.Destinations[0].CostBand)
+	}
+	assert.NotEmpty(t, resp.Facets.CostBands)
+	assert.NotEmpty(t, resp.Facets.Countries)
+
+	resp, err = repo.GetDestinations(&pb.ListDetinationRequest{Query: "paris", CostBand: "luxury", Page: 1, Limit: 10})
+	assert.NoError(t, err)
+	for _, d := range resp.Destinations {
+		assert.NotEqual(t, "Paris", d.Name)
+	}
 }
 
 func TestGetTravelDestination(t *testing.T) {
@@ -77,7 +127,7 @@ func TestGetDestinationActivities(t *testing.T) {
 	repo := NewDestinationRepo(db)
 	id := "a068856b-f64a-4e68-8a3c-e37769bc760a"
 
-	resp, err := repo.GetDestinationActivities(id)
+	resp, err := repo.GetDestinationActivities(id, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 }
@@ -92,7 +142,7 @@ func TestGetDestinationAttractions(t *testing.T) {
 	repo := NewDestinationRepo(db)
 	id := ""
 
-	resp, err := repo.GetDestinationAttractions(id)
+	resp, err := repo.GetDestinationAttractions(id, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 }
@@ -111,3 +161,440 @@ func TestGetTrendingDestinations(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 }
+
+func TestDestinationAdmin(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	created, err := repo.CreateDestination(&pb.AddDestinationRequest{
+		Name:           "Admin Destination",
+		Country:        "Portugal",
+		Currency:       "EUR",
+		Activities:     []string{"Surfing"},
+		TopAttractions: []string{"Belem Tower"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "PT", created.CountryCode)
+	assert.Len(t, created.Activities, 1)
+	assert.Len(t, created.TopAttractions, 1)
+
+	updated, err := repo.UpdateDestination(&pb.UpdateDestinationRequest{
+		Id:                created.Id,
+		Name:              "Admin Destination",
+		Country:           "Portugal",
+		AverageCostPerDay: 120,
+		Currency:          "EUR",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(120), updated.AverageCostPerDay)
+
+	// Fields left out keep their stored values.
+	updated, err = repo.UpdateDestination(&pb.UpdateDestinationRequest{Id: created.Id, Description: "Hills and trams"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Admin Destination", updated.Name)
+	assert.Equal(t, "PT", updated.CountryCode)
+	assert.Equal(t, "EUR", updated.Currency)
+	assert.Equal(t, float32(120), updated.AverageCostPerDay)
+	assert.Equal(t, "Hills and trams", updated.Description)
+
+	item, err := repo.AddDestinationActivity(created.Id, "Tram ride")
+	assert.NoError(t, err)
+
+	activities, err := repo.ListDestinationActivities(created.Id)
+	assert.NoError(t, err)
+	assert.Len(t, activities, 2)
+
+	assert.NoError(t, repo.DeleteDestinationActivity(item.Id))
+	assert.ErrorIs(t, repo.DeleteDestinationActivity(item.Id), sql.ErrNoRows)
+
+	assert.NoError(t, repo.DeleteTopAttraction(created.TopAttractions[0].Id))
+	attractions, err := repo.ListTopAttractions(created.Id)
+	assert.NoError(t, err)
+	assert.Empty(t, attractions)
+
+	assert.NoError(t, repo.DeleteDestination(created.Id))
+	assert.ErrorIs(t, repo.DeleteDestination(created.Id), sql.ErrNoRows)
+
+	_, err = repo.AddTopAttraction(created.Id, "Castle")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestUpsertDestination(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	row := &pb.AddDestinationRequest{
+		Name:       "Import Destination",
+		Country:    "Portugal",
+		Currency:   "EUR",
+		Activities: []string{"Surfing", "Hiking"},
+	}
+
+	_, err = repo.UpsertDestination(row)
+	assert.NoError(t, err)
+
+	_, err = repo.UpsertDestination(&pb.AddDestinationRequest{Name: "Import Region", Country: "Portugal", Kind: models.KindRegion})
+	assert.NoError(t, err)
+	regionId, err := repo.FindDestination("import region", "PORTUGAL")
+	assert.NoError(t, err)
+
+	row.Name = "IMPORT destination"
+	row.Activities = []string{"Sailing"}
+	row.ParentId = regionId
+	again, err := repo.UpsertDestination(row)
+	assert.NoError(t, err)
+	assert.False(t, again)
+
+	var exported *pb.AddDestinationRequest
+	err = repo.ExportDestinations(func(d *pb.AddDestinationRequest) error {
+		if d.Name == row.Name {
+			exported = d
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	if assert.NotNil(t, exported) {
+		assert.Equal(t, "PT", exported.CountryCode)
+		assert.Equal(t, []string{"Sailing"}, exported.Activities)
+		assert.Equal(t, models.KindCity, exported.Kind)
+		assert.Equal(t, "Import Region", exported.ParentName)
+		assert.Equal(t, "Portugal", exported.ParentCountry)
+	}
+}
+
+func TestListNearbyDestinations(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	for _, d := range []*pb.AddDestinationRequest{
+		{Name: "Nearby Lisbon", Country: "Portugal", Coordinates: &pb.GeoPoint{Latitude: 38.7223, Longitude: -9.1393}},
+		{Name: "Nearby Sintra", Country: "Portugal", Coordinates: &pb.GeoPoint{Latitude: 38.8029, Longitude: -9.3817}},
+		{Name: "Nearby Porto", Country: "Portugal", Coordinates: &pb.GeoPoint{Latitude: 41.1579, Longitude: -8.6291}},
+	} {
+		_, err = repo.UpsertDestination(d)
+		assert.NoError(t, err)
+	}
+
+	nearby, err := repo.ListNearbyDestinations(&pb.GeoPoint{Latitude: 38.7223, Longitude: -9.1393}, 50, 10)
+	assert.NoError(t, err)
+
+	var names []string
+	for _, n := range nearby {
+		names = append(names, n.Destination.Name)
+	}
+	assert.Contains(t, names, "Nearby Lisbon")
+	assert.Contains(t, names, "Nearby Sintra")
+	assert.NotContains(t, names, "Nearby Porto")
+
+	for i := 1; i < len(nearby); i++ {
+		assert.LessOrEqual(t, nearby[i-1].DistanceKm, nearby[i].DistanceKm)
+	}
+	for _, n := range nearby {
+		if n.Destination.Name == "Nearby Sintra" {
+			// Lisbon to Sintra is about 22.8 km as the crow flies.
+			assert.InDelta(t, 22.8, n.DistanceKm, 0.5)
+		}
+	}
+}
+
+func TestDestinationPopularity(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	created, err := repo.CreateDestination(&pb.AddDestinationRequest{Name: "Popular Destination", Country: "Portugal"})
+	assert.NoError(t, err)
+
+	userId := "7d3b1d8e-55a4-4f0e-9b7a-3c2f0b1e6a11"
+	assert.NoError(t, repo.BookmarkDestination(userId, created.Id))
+	assert.NoError(t, repo.BookmarkDestination(userId, created.Id))
+
+	engagement, err := repo.ListDestinationEngagement(time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	var bookmarks []models.Engagement
+	for _, e := range engagement {
+		if e.DestinationId == created.Id {
+			bookmarks = append(bookmarks, e)
+		}
+	}
+	if assert.Len(t, bookmarks, 1) {
+		assert.Equal(t, models.EngagementBookmark, bookmarks[0].Kind)
+		assert.Equal(t, 1, bookmarks[0].Count)
+	}
+
+	err = repo.SaveDestinationScores([]models.DestinationScore{
+		{DestinationId: created.Id, Score: 42, TrendingReason: "3 new bookmarks this week"},
+	})
+	assert.NoError(t, err)
+
+	trending, err := repo.GetTrendingDestinations(1000)
+	assert.NoError(t, err)
+	for _, d := range trending.Destinations {
+		if d.Id == created.Id {
+			assert.Equal(t, "42", d.PopularityScore)
+			assert.Equal(t, "3 new bookmarks this week", d.TrendingReason)
+		}
+	}
+
+	assert.NoError(t, repo.RemoveDestinationBookmark(userId, created.Id))
+	assert.ErrorIs(t, repo.RemoveDestinationBookmark(userId, created.Id), sql.ErrNoRows)
+}
+
+func TestDestinationReviews(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	created, err := repo.CreateDestination(&pb.AddDestinationRequest{Name: "Reviewed Destination", Country: "Portugal"})
+	assert.NoError(t, err)
+
+	author := "2f6c7a1e-8b0d-4c55-a3e9-0d1b6f4e2c71"
+	voter := "9a4e2b7c-1d3f-4e8a-b6c5-7f0e1d2a3b94"
+
+	review, err := repo.CreateReview(&pb.AddDestinationReviewRequest{
+		DestinationId: created.Id, UserId: author, Rating: 4, Content: "Lovely", VisitMonth: "2024-05",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-05", review.VisitMonth)
+
+	_, err = repo.CreateReview(&pb.AddDestinationReviewRequest{DestinationId: created.Id, UserId: author, Rating: 5})
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = repo.CreateReview(&pb.AddDestinationReviewRequest{DestinationId: created.Id, UserId: voter, Rating: 1})
+	assert.NoError(t, err)
+
+	count, err := repo.SetReviewVote(review.Id, voter, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), count)
+	count, err = repo.SetReviewVote(review.Id, voter, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), count)
+
+	list, err := repo.ListReviews(&pb.ListDestinationReviewsRequest{DestinationId: created.Id, Page: 1, Limit: 10, SortBy: models.SortLowest})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), list.Total)
+	assert.Equal(t, 2.5, list.AverageRating)
+	if assert.Len(t, list.Reviews, 2) {
+		assert.Equal(t, int32(1), list.Reviews[0].Rating)
+	}
+
+	dest, err := repo.GetTravelDestination(created.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, dest.AverageRating)
+	assert.Equal(t, int32(2), dest.ReviewCount)
+
+	updated, err := repo.UpdateReview(&pb.UpdateDestinationReviewRequest{Id: review.Id, UserId: author, Rating: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, "", updated.VisitMonth)
+	assert.Equal(t, int32(1), updated.HelpfulCount)
+
+	_, err = repo.UpdateReview(&pb.UpdateDestinationReviewRequest{Id: review.Id, UserId: voter, Rating: 1})
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	count, err = repo.SetReviewVote(review.Id, voter, false)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), count)
+
+	assert.NoError(t, repo.DeleteReview(review.Id, author))
+	assert.ErrorIs(t, repo.DeleteReview(review.Id, author), sql.ErrNoRows)
+}
+
+func TestDestinationSeasons(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	created, err := repo.CreateDestination(&pb.AddDestinationRequest{Name: "Seasonal Destination", Country: "Portugal", BestTimeToVisit: "Spring"})
+	assert.NoError(t, err)
+
+	err = repo.SaveDestinationSeasons(created.Id, []*pb.DestinationSeason{
+		{Month: 3, CrowdLevel: models.CrowdLow, TemperatureBand: models.TemperatureMild, Recommended: true},
+		{Month: 8, CrowdLevel: models.CrowdHigh, TemperatureBand: models.TemperatureHot},
+	}, "March")
+	assert.NoError(t, err)
+
+	seasons, err := repo.ListDestinationSeasons(created.Id)
+	assert.NoError(t, err)
+	if assert.Len(t, seasons, 2) {
+		assert.Equal(t, int32(3), seasons[0].Month)
+		assert.True(t, seasons[0].Recommended)
+	}
+
+	_, err = repo.UpdateDestination(&pb.UpdateDestinationRequest{Id: created.Id, Name: "Seasonal Destination", Country: "Portugal", BestTimeToVisit: "Summer"})
+	assert.NoError(t, err)
+	dest, err := repo.GetTravelDestination(created.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "March", dest.BestTimeToVisit)
+
+	march, err := repo.ListDestinationsForMonth(&pb.ListDestinationsForMonthRequest{Month: 3, MaxCrowdLevel: models.CrowdMedium, Page: 1, Limit: 1000})
+	assert.NoError(t, err)
+	found := false
+	for _, d := range march.Destinations {
+		if d.Destination.Id == created.Id {
+			found = true
+			assert.Equal(t, models.CrowdLow, d.Season.CrowdLevel)
+		}
+	}
+	assert.True(t, found)
+
+	august, err := repo.ListDestinationsForMonth(&pb.ListDestinationsForMonthRequest{Month: 8, Page: 1, Limit: 1000})
+	assert.NoError(t, err)
+	for _, d := range august.Destinations {
+		assert.NotEqual(t, created.Id, d.Destination.Id)
+	}
+
+	assert.NoError(t, repo.SaveDestinationSeasons(created.Id, nil, ""))
+	seasons, err = repo.ListDestinationSeasons(created.Id)
+	assert.NoError(t, err)
+	assert.Empty(t, seasons)
+	dest, err = repo.GetTravelDestination(created.Id)
+	assert.NoError(t, err)
+	assert.Empty(t, dest.BestTimeToVisit)
+
+	assert.ErrorIs(t, repo.SaveDestinationSeasons("00000000-0000-0000-0000-000000000000", nil, ""), sql.ErrNoRows)
+}
+
+func TestDestinationTranslations(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	created, err := repo.CreateDestination(&pb.AddDestinationRequest{
+		Name: "Samarkand", Country: "Uzbekistan", Description: "Silk Road city", Activities: []string{"Registan at night"},
+	})
+	assert.NoError(t, err)
+
+	_, err = repo.SaveDestinationTranslation(&pb.SetDestinationTranslationRequest{DestinationId: created.Id, Locale: "uz", Name: "Samarqand"})
+	assert.NoError(t, err)
+	saved, err := repo.SaveDestinationTranslation(&pb.SetDestinationTranslationRequest{DestinationId: created.Id, Locale: "ru", Name: "Самарканд", Description: "Город"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ru", saved.Locale)
+
+	translations, err := repo.GetDestinationTranslations([]string{created.Id}, []string{"uz-UZ", "uz", "ru"})
+	assert.NoError(t, err)
+	if assert.Contains(t, translations, created.Id) {
+		assert.Equal(t, "Samarqand", translations[created.Id].Name)
+	}
+
+	activities, err := repo.ListDestinationActivities(created.Id)
+	assert.NoError(t, err)
+	if assert.Len(t, activities, 1) {
+		_, err = repo.SaveActivityTranslation(activities[0].Id, "uz", "Registon kechasi")
+		assert.NoError(t, err)
+	}
+
+	texts, err := repo.GetDestinationActivities(created.Id, []string{"uz"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Registon kechasi"}, texts)
+	texts, err = repo.GetDestinationActivities(created.Id, []string{"de"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Registan at night"}, texts)
+
+	list, err := repo.ListActivityTranslations(created.Id)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	assert.NoError(t, repo.DeleteDestinationTranslation(created.Id, "uz"))
+	assert.ErrorIs(t, repo.DeleteDestinationTranslation(created.Id, "uz"), sql.ErrNoRows)
+
+	_, err = repo.SaveDestinationTranslation(&pb.SetDestinationTranslationRequest{DestinationId: "00000000-0000-0000-0000-000000000000", Locale: "uz", Name: "X"})
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDestinationHierarchy(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewDestinationRepo(db)
+	country, err := repo.CreateDestination(&pb.AddDestinationRequest{Name: "Hierarchy Country", Country: "Portugal", Kind: models.KindCountry})
+	assert.NoError(t, err)
+	region, err := repo.CreateDestination(&pb.AddDestinationRequest{Name: "Hierarchy Region", Country: "Portugal", Kind: models.KindRegion, ParentId: country.Id})
+	assert.NoError(t, err)
+	city, err := repo.CreateDestination(&pb.AddDestinationRequest{Name: "Hierarchy City", Country: "Portugal", ParentId: region.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, models.KindCity, city.Kind)
+	assert.Equal(t, region.Id, city.ParentId)
+	poi, err := repo.CreateDestination(&pb.AddDestinationRequest{Name: "Hierarchy Museum", Country: "Portugal", Kind: models.KindPOI, ParentId: city.Id})
+	assert.NoError(t, err)
+
+	crumbs, err := repo.ListBreadcrumbs(poi.Id)
+	assert.NoError(t, err)
+	if assert.Len(t, crumbs, 3) {
+		assert.Equal(t, country.Id, crumbs[0].Id)
+		assert.Equal(t, city.Id, crumbs[2].Id)
+	}
+
+	children, err := repo.ListChildDestinations(&pb.ListChildDestinationsRequest{DestinationId: country.Id, Page: 1, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), children.Total)
+
+	all, err := repo.ListChildDestinations(&pb.ListChildDestinationsRequest{DestinationId: country.Id, Recursive: true, Page: 1, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), all.Total)
+
+	pois, err := repo.ListChildDestinations(&pb.ListChildDestinationsRequest{DestinationId: country.Id, Recursive: true, Kind: models.KindPOI, Page: 1, Limit: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, pois.Destinations, 1) {
+		assert.Equal(t, poi.Id, pois.Destinations[0].Id)
+	}
+
+	within, err := repo.GetDestinations(&pb.ListDetinationRequest{Query: "museum", WithinId: region.Id, Page: 1, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), within.Total)
+
+	_, err = repo.CreateReview(&pb.AddDestinationReviewRequest{DestinationId: poi.Id, UserId: "5b1e9c3a-7d2f-4a6b-8e0c-1f3d5a7b9c2e", Rating: 4})
+	assert.NoError(t, err)
+	dest, err := repo.GetTravelDestination(country.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), dest.ReviewCount)
+	assert.Equal(t, 4.0, dest.AverageRating)
+	reviews, err := repo.ListReviews(&pb.ListDestinationReviewsRequest{DestinationId: country.Id, Page: 1, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, dest.ReviewCount, reviews.Total)
+	assert.Equal(t, dest.AverageRating, reviews.AverageRating)
+
+	updated, err := repo.UpdateDestination(&pb.UpdateDestinationRequest{Id: poi.Id, Name: "Hierarchy Museum", Country: "Portugal"})
+	assert.NoError(t, err)
+	assert.Equal(t, models.KindPOI, updated.Kind)
+	assert.Equal(t, city.Id, updated.ParentId)
+
+	updated, err = repo.UpdateDestination(&pb.UpdateDestinationRequest{Id: poi.Id, Name: "Hierarchy Museum", Country: "Portugal", ClearParent: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "", updated.ParentId)
+
+	kinds, err := repo.ListChildKinds(region.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{models.KindCity}, kinds)
+
+	assert.NoError(t, repo.DeleteDestination(region.Id))
+	dest, err = repo.GetTravelDestination(city.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, country.Id, dest.ParentId)
+}
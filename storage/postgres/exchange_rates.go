@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"content-service/models"
+	"database/sql"
+)
+
+type ExchangeRateRepo struct {
+	DB *sql.DB
+}
+
+func NewExchangeRateRepo(db *sql.DB) *ExchangeRateRepo {
+	return &ExchangeRateRepo{
+		DB: db,
+	}
+}
+
+// UpsertRates stores the rates in one transaction, replacing rates already
+// known for the same currency and day.
+func (repo *ExchangeRateRepo) UpsertRates(rates []models.ExchangeRate) error {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, rate := range rates {
+		_, err = tx.Exec(`
+			INSERT INTO exchange_rates (
+				currency,
+				rate_date,
+				rate
+			)
+			VALUES (
+				$1,
+				$2,
+				$3
+			)
+			ON CONFLICT (currency, rate_date) DO UPDATE SET
+				rate = EXCLUDED.rate,
+				updated_at = CURRENT_TIMESTAMP
+		`, rate.Currency, rate.RateDate, rate.Rate)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetLatestRates returns the most recent rate of every currency.
+func (repo *ExchangeRateRepo) GetLatestRates() ([]models.ExchangeRate, error) {
+	rows, err := repo.DB.Query(`
+		SELECT DISTINCT ON (currency)
+			currency,
+			rate,
+			TO_CHAR(rate_date, 'YYYY-MM-DD')
+		FROM
+			exchange_rates
+		ORDER BY
+			currency, rate_date DESC
+	`)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []models.ExchangeRate
+	for rows.Next() {
+		var rate models.ExchangeRate
+
+		if err = rows.Scan(&rate.Currency, &rate.Rate, &rate.RateDate); err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, rate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"content-service/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExchangeRates(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewExchangeRateRepo(db)
+
+	err = repo.UpsertRates([]models.ExchangeRate{
+		{Currency: "EUR", Rate: 0.93, RateDate: "2024-04-30"},
+		{Currency: "EUR", Rate: 0.92, RateDate: "2024-05-01"},
+	})
+	assert.NoError(t, err)
+
+	rates, err := repo.GetLatestRates()
+	assert.NoError(t, err)
+
+	for _, rate := range rates {
+		if rate.Currency == "EUR" {
+			assert.Equal(t, 0.92, rate.Rate)
+			assert.Equal(t, "2024-05-01", rate.RateDate)
+		}
+	}
+}
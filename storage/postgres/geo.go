@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"content-service/models"
+	"database/sql"
+)
+
+// SetDestinationCoordinates moves the pin of a stay, or removes it when p is
+// nil.
+func (repo *ItinerariesRepo) SetDestinationCoordinates(destinationId string, p *models.GeoPoint) error {
+	lat, lng := geoArgs(p)
+
+	res, err := repo.DB.Exec(`
+		UPDATE
+			itinerary_destinations
+		SET
+			latitude = $2,
+			longitude = $3
+		WHERE
+			id = $1
+	`, destinationId, lat, lng)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+// geoArgs turns an optional point into query arguments, NULL when unset.
+func geoArgs(p *models.GeoPoint) (interface{}, interface{}) {
+	if p == nil {
+		return nil, nil
+	}
+
+	return p.Latitude, p.Longitude
+}
+
+func geoPoint(lat, lng sql.NullFloat64) *models.GeoPoint {
+	if !lat.Valid || !lng.Valid {
+		return nil
+	}
+
+	return &models.GeoPoint{Latitude: lat.Float64, Longitude: lng.Float64}
+}
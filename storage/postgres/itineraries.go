@@ -6,6 +6,10 @@ import (
 	"content-service/models"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
 )
 
 type ItinerariesRepo struct {
@@ -18,23 +22,51 @@ func NewItinerariesRepo(db *sql.DB) *ItinerariesRepo {
 	}
 }
 
+// queryRower is satisfied by both *sql.DB and *sql.Tx, so the insert helpers
+// below can run standalone or as part of the itinerary creation transaction.
+type queryRower interface {
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// CreateItinerary stores the itinerary together with its destinations and
+// their activities in a single transaction.
 func (repo *ItinerariesRepo) CreateItinerary(req *pb.CreateItineraryRequest) (*pb.CreateItineraryResponse, error) {
+	return repo.createItinerary(req, "")
+}
+
+// InstantiateTemplate creates an itinerary planned from a template and
+// remembers which template it came from.
+func (repo *ItinerariesRepo) InstantiateTemplate(templateId string, req *pb.CreateItineraryRequest) (*pb.CreateItineraryResponse, error) {
+	return repo.createItinerary(req, templateId)
+}
+
+func (repo *ItinerariesRepo) createItinerary(req *pb.CreateItineraryRequest, templateId string) (*pb.CreateItineraryResponse, error) {
 	var resp pb.CreateItineraryResponse
 
-	err := repo.DB.QueryRow(`
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(`
 		INSERT INTO itineraries (
 			title,
 			description,
 			start_date,
 			end_date,
-			author_id
+			author_id,
+			currency,
+			template_id
 		)
 		VALUES (
 			$1,
 			$2,
 			$3,
 			$4,
-			$5
+			$5,
+			COALESCE(NULLIF($6, ''), 'USD'),
+			NULLIF($7, '')::UUID
 		)
 		RETURNING
 			id,
@@ -43,14 +75,65 @@ func (repo *ItinerariesRepo) CreateItinerary(req *pb.CreateItineraryRequest) (*p
 			start_date,
 			end_date,
 			author_id,
-			created_at
-	`, req.Title, req.Description, req.StartDate, req.EndDate, req.AthorId).
-		Scan(&resp.Id, &resp.Title, &resp.Description, &resp.StartDate, &resp.EndDate, &resp.AuthorId, &resp.CreatedAt)
+			created_at,
+			currency
+	`, req.Title, req.Description, req.StartDate, req.EndDate, req.AthorId, req.Currency, templateId).
+		Scan(&resp.Id, &resp.Title, &resp.Description, &resp.StartDate, &resp.EndDate, &resp.AuthorId, &resp.CreatedAt, &resp.Currency)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if err = insertItineraryOwner(tx, resp.Id, resp.AuthorId); err != nil {
+		return nil, err
+	}
+
+	for _, d := range req.Distinations {
+		destinationId, err := insertItineraryDestination(tx, models.ItineraryDestination{
+			ItineraryId:          resp.Id,
+			Name:                 d.Name,
+			StartDate:            d.StartDate,
+			EndDate:              d.EndDate,
+			CatalogDestinationId: d.CatalogDestinationId,
+			Coordinates:          models.GeoPointFromPb(d.Coordinates),
+			CountryCode:          d.CountryCode,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range d.Activities {
+			_, err = insertItineraryActivity(tx, models.ItineraryActivity{
+				DestinationId: destinationId,
+				Activity:      a,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, a := range d.Schedule {
+			_, err = insertItineraryActivity(tx, models.ItineraryActivity{
+				DestinationId: destinationId,
+				Activity:      a.Title,
+				Day:           a.Day,
+				StartTime:     a.StartTime,
+				EndTime:       a.EndTime,
+				TimeZone:      a.TimeZone,
+				Location:      a.Location,
+				Notes:         a.Notes,
+				Coordinates:   models.GeoPointFromPb(a.Coordinates),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return &resp, nil
 }
 
@@ -62,7 +145,8 @@ func (repo *ItinerariesRepo) UpdateItinerary(req *pb.UpdateItineraryRequest) (*p
 			itineraries
 		SET
 			title = $1,
-			description = $2
+			description = $2,
+			currency = COALESCE(NULLIF($4, ''), currency)
 		WHERE
 			id = $3 and deleted_at = 0
 		RETURNING
@@ -72,8 +156,9 @@ func (repo *ItinerariesRepo) UpdateItinerary(req *pb.UpdateItineraryRequest) (*p
 			start_date,
 			end_date,
 			author_id,
-			updated_at
-	`, req.Title, req.Description, req.Id).Scan(&resp.Id, &resp.Title, &resp.Description, &resp.StartDate, &resp.EndDate, &resp.AuthorId, &resp.UpdatedAt)
+			updated_at,
+			currency
+	`, req.Title, req.Description, req.Id, req.Currency).Scan(&resp.Id, &resp.Title, &resp.Description, &resp.StartDate, &resp.EndDate, &resp.AuthorId, &resp.UpdatedAt, &resp.Currency)
 
 	if err != nil {
 		return nil, err
@@ -115,6 +200,83 @@ func (repo *ItinerariesRepo) GetItinerary(id string) (*pb.GetItineraryResponse,
 	var author pb.Author
 	err := repo.DB.QueryRow(`
 		SELECT
+			i.id,
+			i.title,
+			i.description,
+			i.start_date,
+			i.end_date,
+			i.author_id,
+			i.created_at,
+			i.updated_at,
+			COALESCE(i.forked_from::TEXT, ''),
+			(
+				SELECT COUNT(*) FROM itineraries f
+				WHERE f.forked_from = i.id AND f.deleted_at = 0
+			),
+			i.currency
+		FROM
+			itineraries i
+		WHERE
+			i.deleted_at = 0 AND i.id = $1
+	`, id).Scan(&resp.Id, &resp.Title, &resp.Description, &resp.StartDate, &resp.EndDate, &author.Id, &resp.CreatedAt, &resp.UpdatedAt, &resp.ForkedFrom, &resp.ForksCount, &resp.Currency)
+
+	if err != nil {
+		return nil, err
+	}
+	resp.Author = &author
+
+	return &resp, nil
+}
+
+// ForkItinerary deep-copies an itinerary with its destinations and activities
+// into userId's account. When startDate (YYYY-MM-DD) is set every date of the
+// copy is shifted by the same number of days so the trip starts on it.
+func (repo *ItinerariesRepo) ForkItinerary(id, userId, startDate, title string) (*pb.CreateItineraryResponse, error) {
+	var resp pb.CreateItineraryResponse
+
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var shift int
+	err = tx.QueryRow(`
+		SELECT
+			COALESCE(NULLIF($2, '')::DATE - start_date, 0)
+		FROM
+			itineraries
+		WHERE
+			deleted_at = 0 AND id = $1
+	`, id, startDate).Scan(&shift)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(`
+		INSERT INTO itineraries (
+			title,
+			description,
+			start_date,
+			end_date,
+			author_id,
+			forked_from,
+			currency
+		)
+		SELECT
+			COALESCE(NULLIF($3, ''), title),
+			description,
+			start_date + $4::INT,
+			end_date + $4::INT,
+			$2,
+			id,
+			currency
+		FROM
+			itineraries
+		WHERE
+			id = $1
+		RETURNING
 			id,
 			title,
 			description,
@@ -122,17 +284,118 @@ func (repo *ItinerariesRepo) GetItinerary(id string) (*pb.GetItineraryResponse,
 			end_date,
 			author_id,
 			created_at,
-			updated_at
+			currency
+	`, id, userId, title, shift).
+		Scan(&resp.Id, &resp.Title, &resp.Description, &resp.StartDate, &resp.EndDate, &resp.AuthorId, &resp.CreatedAt, &resp.Currency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = insertItineraryOwner(tx, resp.Id, resp.AuthorId); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(`
+		SELECT
+			id
 		FROM
-			itineraries
+			itinerary_destinations
 		WHERE
-			deleted_at = 0 AND id = $1
-	`, id).Scan(&resp.Id, &resp.Title, &resp.Description, &resp.StartDate, &resp.EndDate, &author.Id, &resp.CreatedAt, &resp.UpdatedAt)
+			itinerary_id = $1
+	`, id)
 
 	if err != nil {
 		return nil, err
 	}
-	resp.Author = &author
+
+	var destinations []string
+	for rows.Next() {
+		var destinationId string
+		if err = rows.Scan(&destinationId); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		destinations = append(destinations, destinationId)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, destinationId := range destinations {
+		var copyId string
+		err = tx.QueryRow(`
+			INSERT INTO itinerary_destinations (
+				itinerary_id,
+				name,
+				start_date,
+				end_date,
+				destination_id,
+				latitude,
+				longitude,
+				country_code
+			)
+			SELECT
+				$1,
+				name,
+				start_date + $3::INT,
+				end_date + $3::INT,
+				destination_id,
+				latitude,
+				longitude,
+				country_code
+			FROM
+				itinerary_destinations
+			WHERE
+				id = $2
+			RETURNING
+				id
+		`, resp.Id, destinationId, shift).Scan(&copyId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO itinerary_activities (
+				destination_id,
+				activity,
+				day,
+				start_time,
+				end_time,
+				time_zone,
+				location,
+				notes,
+				latitude,
+				longitude
+			)
+			SELECT
+				$1,
+				activity,
+				day + $3::INT,
+				start_time,
+				end_time,
+				time_zone,
+				location,
+				notes,
+				latitude,
+				longitude
+			FROM
+				itinerary_activities
+			WHERE
+				destination_id = $2
+		`, copyId, destinationId, shift)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	return &resp, nil
 }
@@ -140,31 +403,42 @@ func (repo *ItinerariesRepo) GetItinerary(id string) (*pb.GetItineraryResponse,
 func (repo *ItinerariesRepo) ListItineraries(req *pb.ListItinerariesRequest) (*pb.ListItinerariesResponse, error) {
 	var resp []*pb.Itinerary
 	offset := (req.Page - 1) * req.Limit
-	rows, err := repo.DB.Query(`
+
+	filter, args := itineraryFilter(req)
+	ind := len(args) + 1
+
+	order := "i.created_at DESC"
+	if req.SortBy == models.SortPopular {
+		order = "i.likes_count DESC, i.comments_count DESC, i.created_at DESC"
+	}
+
+	query := `
 		SELECT
-			id,
-			title,
-			author_id,
-			start_date,
-			end_date,
-			created_at
+			i.id,
+			i.title,
+			i.author_id,
+			i.start_date,
+			i.end_date,
+			i.likes_count,
+			i.comments_count,
+			i.created_at
 		FROM
-			itineraries
-		WHERE
-			deleted_at = 0
-		OFFSET $1
-		LIMIT $2
-	`, offset, req.Limit)
+			itineraries i
+		WHERE ` + filter + `
+		ORDER BY ` + order
+	query += fmt.Sprintf(" OFFSET $%d LIMIT $%d", ind, ind+1)
 
+	rows, err := repo.DB.Query(query, append(args, offset, req.Limit)...)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var itinerary pb.Itinerary
 		var author pb.Authors
 
-		err = rows.Scan(&itinerary.Id, &itinerary.Title, &author.Id, &itinerary.StartDate, &itinerary.EndDate, &itinerary.CreatedAt)
+		err = rows.Scan(&itinerary.Id, &itinerary.Title, &author.Id, &itinerary.StartDate, &itinerary.EndDate, &itinerary.LikesCount, &itinerary.CommentsCount, &itinerary.CreatedAt)
 
 		if err != nil {
 			return nil, err
@@ -183,10 +457,8 @@ func (repo *ItinerariesRepo) ListItineraries(req *pb.ListItinerariesRequest) (*p
 		SELECT 
 			COUNT(*) 
 		FROM
-			itineraries
-		WHERE
-			deleted_at = 0
-	`).Scan(&total)
+			itineraries i
+		WHERE `+filter, args...).Scan(&total)
 
 	if err != nil {
 		return nil, err
@@ -200,75 +472,276 @@ func (repo *ItinerariesRepo) ListItineraries(req *pb.ListItinerariesRequest) (*p
 	}, nil
 }
 
+// itineraryFilter builds the WHERE clause of ListItineraries. Destination
+// and month conditions have to hold for the same stop, so "Lisbon in April"
+// does not match a trip that is in Lisbon in March and in Porto in April.
+func itineraryFilter(req *pb.ListItinerariesRequest) (string, []interface{}) {
+	var args []interface{}
+	ind := 1
+	filter := "i.deleted_at = 0"
+
+	if req.AuthorId != "" {
+		filter += fmt.Sprintf(" AND i.author_id = $%d", ind)
+		ind++
+		args = append(args, req.AuthorId)
+	}
+	if req.MinDays > 0 {
+		filter += fmt.Sprintf(" AND i.end_date - i.start_date + 1 >= $%d", ind)
+		ind++
+		args = append(args, req.MinDays)
+	}
+	if req.MaxDays > 0 {
+		filter += fmt.Sprintf(" AND i.end_date - i.start_date + 1 <= $%d", ind)
+		ind++
+		args = append(args, req.MaxDays)
+	}
+
+	// travelsIn matches when [start, end] touches one of the months.
+	travelsIn := func(start, end string) string {
+		return fmt.Sprintf(`EXISTS (
+				SELECT 1 FROM GENERATE_SERIES(DATE_TRUNC('month', %s::TIMESTAMP), %s::TIMESTAMP, INTERVAL '1 month') m
+				WHERE EXTRACT(MONTH FROM m)::INT = ANY($%d)
+			)`, start, end, ind)
+	}
+
+	var stop []string
+	if req.Destination != "" {
+		stop = append(stop, fmt.Sprintf("i_d.name ILIKE $%d", ind))
+		ind++
+		args = append(args, "%"+escapeLike(req.Destination)+"%")
+	}
+	if req.CatalogDestinationId != "" {
+		stop = append(stop, fmt.Sprintf("i_d.destination_id = $%d", ind))
+		ind++
+		args = append(args, req.CatalogDestinationId)
+	}
+
+	if len(req.Months) > 0 {
+		if len(stop) > 0 {
+			stop = append(stop, travelsIn("i_d.start_date", "i_d.end_date"))
+		} else {
+			filter += " AND " + travelsIn("i.start_date", "i.end_date")
+		}
+		args = append(args, pq.Array(req.Months))
+	}
+
+	if len(stop) > 0 {
+		filter += `
+			AND EXISTS (
+				SELECT 1 FROM itinerary_destinations i_d
+				WHERE i_d.itinerary_id = i.id AND ` + strings.Join(stop, " AND ") + `
+			)`
+	}
+
+	return filter, args
+}
+
+// escapeLike makes s match literally inside a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func (repo *ItinerariesRepo) CreateItineraryDestinations(req models.ItineraryDestination) error {
-	_, err := repo.DB.Exec(`
+	_, err := insertItineraryDestination(repo.DB, req)
+
+	return err
+}
+
+func insertItineraryDestination(q queryRower, req models.ItineraryDestination) (string, error) {
+	var id string
+	lat, lng := geoArgs(req.Coordinates)
+	err := q.QueryRow(`
 		INSERT INTO itinerary_destinations (
 			itinerary_id,
 			name,
 			start_date,
-			end_date
+			end_date,
+			destination_id,
+			latitude,
+			longitude,
+			country_code
 		)
 		VALUES (
 			$1,
 			$2,
 			$3,
-			$4
+			$4,
+			NULLIF($5, '')::UUID,
+			$6,
+			$7,
+			NULLIF($8, '')
 		)
-	`, req.ItineraryId, req.Name, req.StartDate, req.EndDate)
+		RETURNING
+			id
+	`, req.ItineraryId, req.Name, req.StartDate, req.EndDate, req.CatalogDestinationId, lat, lng, req.CountryCode).Scan(&id)
 
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return id, nil
 }
 
 func (repo *ItinerariesRepo) CreateItineraryActivity(req models.ItineraryActivity) error {
-	_, err := repo.DB.Exec(`
+	_, err := insertItineraryActivity(repo.DB, req)
+
+	return err
+}
+
+// AddItineraryActivity stores a single activity and returns it the way it was
+// saved, including defaults filled in by the database.
+func (repo *ItinerariesRepo) AddItineraryActivity(req models.ItineraryActivity) (*models.ItineraryActivity, error) {
+	return insertItineraryActivity(repo.DB, req)
+}
+
+func insertItineraryActivity(q queryRower, req models.ItineraryActivity) (*models.ItineraryActivity, error) {
+	var resp models.ItineraryActivity
+	var respLat, respLng sql.NullFloat64
+	lat, lng := geoArgs(req.Coordinates)
+
+	err := q.QueryRow(`
 		INSERT INTO itinerary_activities (
 			destination_id,
-			activity
+			activity,
+			day,
+			start_time,
+			end_time,
+			time_zone,
+			location,
+			notes,
+			latitude,
+			longitude
 		)
 		VALUES (
 			$1,
-			$2
+			$2,
+			NULLIF($3, '')::DATE,
+			NULLIF($4, '')::TIME,
+			NULLIF($5, '')::TIME,
+			COALESCE(NULLIF($6, ''), 'UTC'),
+			NULLIF($7, ''),
+			NULLIF($8, ''),
+			$9,
+			$10
 		)
-	`, req.DestinationId, req.Activity)
+		RETURNING
+			id,
+			destination_id,
+			activity,
+			COALESCE(TO_CHAR(day, 'YYYY-MM-DD'), ''),
+			COALESCE(TO_CHAR(start_time, 'HH24:MI'), ''),
+			COALESCE(TO_CHAR(end_time, 'HH24:MI'), ''),
+			time_zone,
+			COALESCE(location, ''),
+			COALESCE(notes, ''),
+			latitude,
+			longitude
+	`, req.DestinationId, req.Activity, req.Day, req.StartTime, req.EndTime, req.TimeZone, req.Location, req.Notes, lat, lng).
+		Scan(&resp.ID, &resp.DestinationId, &resp.Activity, &resp.Day, &resp.StartTime, &resp.EndTime, &resp.TimeZone, &resp.Location, &resp.Notes, &respLat, &respLng)
+
+	if err != nil {
+		return nil, err
+	}
+	resp.Coordinates = geoPoint(respLat, respLng)
+
+	return &resp, nil
+}
+
+func (repo *ItinerariesRepo) DeleteItineraryActivity(id string) (*pb.DeleteItineraryActivityResponse, error) {
+	res, err := repo.DB.Exec(`
+		DELETE FROM itinerary_activities
+		WHERE id = $1
+	`, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &pb.DeleteItineraryActivityResponse{
+		Message: "Activity deleted successfully",
+	}, nil
+}
+
+func (repo *ItinerariesRepo) GetItineraryDestination(id string) (*models.ItineraryDestination, error) {
+	var resp models.ItineraryDestination
+	var lat, lng sql.NullFloat64
+
+	err := repo.DB.QueryRow(`
+		SELECT
+			i_d.id,
+			i_d.itinerary_id,
+			i_d.name,
+			TO_CHAR(i_d.start_date, 'YYYY-MM-DD'),
+			TO_CHAR(i_d.end_date, 'YYYY-MM-DD'),
+			COALESCE(i_d.destination_id::TEXT, ''),
+			i_d.latitude,
+			i_d.longitude,
+			COALESCE(i_d.country_code, d.country_code, '')
+		FROM
+			itinerary_destinations i_d
+		JOIN
+			itineraries i ON i.id = i_d.itinerary_id
+		LEFT JOIN
+			destinations d ON d.id = i_d.destination_id
+		WHERE
+			i.deleted_at = 0 AND i_d.id = $1
+	`, id).Scan(&resp.ID, &resp.ItineraryId, &resp.Name, &resp.StartDate, &resp.EndDate, &resp.CatalogDestinationId, &lat, &lng, &resp.CountryCode)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
+	resp.Coordinates = geoPoint(lat, lng)
 
-	return nil
+	return &resp, nil
 }
 
 func (repo *ItinerariesRepo) GetItineraryDestinations(id string) ([]models.Result, error) {
 	var destinations []models.Result
 	rows, err := repo.DB.Query(`
 		SELECT
-			id,
-			name,
-			start_date,
-			end_date
+			i_d.id,
+			i_d.name,
+			TO_CHAR(i_d.start_date, 'YYYY-MM-DD'),
+			TO_CHAR(i_d.end_date, 'YYYY-MM-DD'),
+			COALESCE(i_d.destination_id::TEXT, ''),
+			i_d.latitude,
+			i_d.longitude,
+			COALESCE(i_d.country_code, d.country_code, '')
 		FROM
 			itinerary_destinations i_d
 		JOIN 
 			itineraries i ON i.id = i_d.itinerary_id
+		LEFT JOIN
+			destinations d ON d.id = i_d.destination_id
 		WHERE
 			i.deleted_at = 0 and i_d.itinerary_id = $1
+		ORDER BY
+			i_d.start_date
 	`, id)
 
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var res models.Result
+		var lat, lng sql.NullFloat64
 
-		err = rows.Scan(res.ID, &res.Name, &res.StartDate, &res.EndDate)
+		err = rows.Scan(&res.ID, &res.Name, &res.StartDate, &res.EndDate, &res.CatalogDestinationId, &lat, &lng, &res.CountryCode)
 		if err != nil {
 			return nil, err
 		}
+		res.Coordinates = geoPoint(lat, lng)
 
 		destinations = append(destinations, res)
 	}
@@ -314,10 +787,67 @@ func (repo *ItinerariesRepo) GetItineraryActivity(id string) ([]string, error) {
 	return destinations, nil
 }
 
+// GetDestinationSchedule returns the activities planned for an itinerary
+// destination, ordered by day and start time.
+func (repo *ItinerariesRepo) GetDestinationSchedule(id string) ([]models.ItineraryActivity, error) {
+	var activities []models.ItineraryActivity
+
+	rows, err := repo.DB.Query(`
+		SELECT
+			id,
+			destination_id,
+			activity,
+			COALESCE(TO_CHAR(day, 'YYYY-MM-DD'), ''),
+			COALESCE(TO_CHAR(start_time, 'HH24:MI'), ''),
+			COALESCE(TO_CHAR(end_time, 'HH24:MI'), ''),
+			time_zone,
+			COALESCE(location, ''),
+			COALESCE(notes, ''),
+			latitude,
+			longitude
+		FROM
+			itinerary_activities
+		WHERE
+			destination_id = $1
+		ORDER BY
+			day NULLS LAST, start_time NULLS FIRST
+	`, id)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var act models.ItineraryActivity
+		var lat, lng sql.NullFloat64
+
+		err = rows.Scan(&act.ID, &act.DestinationId, &act.Activity, &act.Day, &act.StartTime, &act.EndTime, &act.TimeZone, &act.Location, &act.Notes, &lat, &lng)
+		if err != nil {
+			return nil, err
+		}
+		act.Coordinates = geoPoint(lat, lng)
+
+		activities = append(activities, act)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
+
 func (repo *ItinerariesRepo) CreateItineraryComments(req *pb.LeaveCommentRequest) (*pb.LeaveCommentResponse, error) {
 	var resp pb.LeaveCommentResponse
 
-	err := repo.DB.QueryRow(`
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(`
 		INSERT INTO itinerary_comments (
 			author_id,
 			itinerary_id,
@@ -336,7 +866,309 @@ func (repo *ItinerariesRepo) CreateItineraryComments(req *pb.LeaveCommentRequest
 			created_at
 	`, req.AuthorId, req.ItineraryId, req.Content).Scan(&resp.Id, &resp.Content, &resp.AuthorId, &resp.ItineraryId, &resp.CreatedAt)
 
-	return &resp, err
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE
+			itineraries
+		SET
+			comments_count = comments_count + 1
+		WHERE
+			id = $1
+	`, req.ItineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (repo *ItinerariesRepo) GetItineraryComments(req *pb.ListItineraryCommentsRequest) (*pb.ListItineraryCommentsResponse, error) {
+	var resp []*pb.ItineraryComment
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := repo.DB.Query(`
+		SELECT
+			c.id,
+			c.itinerary_id,
+			c.content,
+			c.author_id,
+			c.created_at,
+			c.updated_at
+		FROM
+			itinerary_comments c
+		JOIN
+			itineraries i ON i.id = c.itinerary_id
+		WHERE
+			i.deleted_at = 0 AND c.itinerary_id = $1
+		ORDER BY
+			c.created_at
+		OFFSET $2
+		LIMIT $3
+	`, req.ItineraryId, offset, req.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var comment pb.ItineraryComment
+		var author pb.Authors
+
+		err = rows.Scan(&comment.Id, &comment.ItineraryId, &comment.Content, &author.Id, &comment.CreatedAt, &comment.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		comment.Author = &author
+
+		resp = append(resp, &comment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	total, err := repo.CountItineraryComments(req.ItineraryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListItineraryCommentsResponse{
+		Comments: resp,
+		Total:    total,
+		Limit:    req.Limit,
+		Page:     req.Page,
+	}, nil
+}
+
+// GetItineraryComment returns a comment with only its author id filled in.
+func (repo *ItinerariesRepo) GetItineraryComment(id string) (*pb.ItineraryComment, error) {
+	var comment pb.ItineraryComment
+	var author pb.Authors
+
+	err := repo.DB.QueryRow(`
+		SELECT
+			id,
+			itinerary_id,
+			content,
+			author_id,
+			created_at,
+			updated_at
+		FROM
+			itinerary_comments
+		WHERE
+			id = $1
+	`, id).Scan(&comment.Id, &comment.ItineraryId, &comment.Content, &author.Id, &comment.CreatedAt, &comment.UpdatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+	comment.Author = &author
+
+	return &comment, nil
+}
+
+// UpdateItineraryComment changes the text of a comment. Only the author can
+// edit, for anyone else sql.ErrNoRows is returned.
+func (repo *ItinerariesRepo) UpdateItineraryComment(req *pb.UpdateItineraryCommentRequest) (*pb.ItineraryComment, error) {
+	var comment pb.ItineraryComment
+	var author pb.Authors
+
+	err := repo.DB.QueryRow(`
+		UPDATE
+			itinerary_comments
+		SET
+			content = $3,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			id = $1 AND author_id = $2
+		RETURNING
+			id,
+			itinerary_id,
+			content,
+			author_id,
+			created_at,
+			updated_at
+	`, req.Id, req.AuthorId, req.Content).Scan(&comment.Id, &comment.ItineraryId, &comment.Content, &author.Id, &comment.CreatedAt, &comment.UpdatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+	comment.Author = &author
+
+	return &comment, nil
+}
+
+func (repo *ItinerariesRepo) DeleteItineraryComment(id string) (*pb.DeleteItineraryCommentResponse, error) {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var itineraryId string
+	err = tx.QueryRow(`
+		DELETE FROM itinerary_comments
+		WHERE id = $1
+		RETURNING itinerary_id
+	`, id).Scan(&itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE
+			itineraries
+		SET
+			comments_count = GREATEST(comments_count - 1, 0)
+		WHERE
+			id = $1
+	`, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteItineraryCommentResponse{
+		Message: "Comment deleted successfully",
+	}, nil
+}
+
+// LikeItinerary records a like. Liking twice is not an error, the original
+// like is kept.
+func (repo *ItinerariesRepo) LikeItinerary(itineraryId, userId string) (*pb.LikeItineraryResponse, error) {
+	resp := pb.LikeItineraryResponse{
+		ItineraryId: itineraryId,
+		UserId:      userId,
+	}
+
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+		INSERT INTO itinerary_likes (
+			user_id,
+			itinerary_id
+		)
+		SELECT
+			$1,
+			id
+		FROM
+			itineraries
+		WHERE
+			id = $2 AND deleted_at = 0
+		ON CONFLICT (user_id, itinerary_id) DO NOTHING
+	`, userId, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(`
+		UPDATE
+			itineraries
+		SET
+			likes_count = likes_count + $2
+		WHERE
+			id = $1 AND deleted_at = 0
+		RETURNING
+			likes_count
+	`, itineraryId, rowsAffected).Scan(&resp.LikesCount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(`
+		SELECT
+			created_at
+		FROM
+			itinerary_likes
+		WHERE
+			user_id = $1 AND itinerary_id = $2
+	`, userId, itineraryId).Scan(&resp.LikedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (repo *ItinerariesRepo) UnlikeItinerary(itineraryId, userId string) (*pb.UnlikeItineraryResponse, error) {
+	var resp pb.UnlikeItineraryResponse
+
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+		DELETE FROM itinerary_likes
+		WHERE user_id = $1 AND itinerary_id = $2
+	`, userId, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	err = tx.QueryRow(`
+		UPDATE
+			itineraries
+		SET
+			likes_count = GREATEST(likes_count - 1, 0)
+		WHERE
+			id = $1
+		RETURNING
+			likes_count
+	`, itineraryId).Scan(&resp.LikesCount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	resp.Message = "Like removed successfully"
+
+	return &resp, nil
 }
 
 func (repo *ItinerariesRepo) CountItinerary(id string) (int32, error) {
@@ -363,9 +1195,9 @@ func (repo *ItinerariesRepo) CountItineraryComments(id string) (int32, error) {
 		SELECT 
 			COUNT(*) 
 		FROM 
-			itineraries
+			itinerary_comments
 		WHERE
-			(deleted_at = 0) AND (author_id = $1 or id = $1) 
+			itinerary_id = $1
 	`, id).Scan(&total)
 
 	if err != nil {
@@ -375,21 +1207,22 @@ func (repo *ItinerariesRepo) CountItineraryComments(id string) (int32, error) {
 	return total, nil
 }
 
-func (repo *ItinerariesRepo) TotalCountriesVisited(id string) (int32, error) {
+func (repo *ItinerariesRepo) CountItineraryLikes(id string) (int32, error) {
 	var total int32
-
 	err := repo.DB.QueryRow(`
 		SELECT 
-			COUNT(DISTINCT id.id) 
+			COUNT(*) 
 		FROM 
-			itinerary_destinations id
-		JOIN 
-			itineraries i ON id.itinerary_id = i.id
-		WHERE 
-			i.author_id = $1 AND i.deleted_at = 0;
+			itinerary_likes
+		WHERE
+			itinerary_id = $1
 	`, id).Scan(&total)
 
-	return total, err
+	if err != nil {
+		return -1, err
+	}
+
+	return total, nil
 }
 
 func (repo *ItinerariesRepo) MostPopularItinerary(id string) (*communication.MostPopularItinerary, error) {
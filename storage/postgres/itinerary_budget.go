@@ -0,0 +1,320 @@
+package postgres
+
+import (
+	"content-service/models"
+	"database/sql"
+)
+
+// LinkCatalogDestination points a stay at a catalog destination, or unlinks
+// it when catalogId is empty. It returns sql.ErrNoRows when either side does
+// not exist.
+func (repo *ItinerariesRepo) LinkCatalogDestination(destinationId, catalogId string) error {
+	res, err := repo.DB.Exec(`
+		UPDATE
+			itinerary_destinations
+		SET
+			destination_id = NULLIF($2, '')::UUID
+		WHERE
+			id = $1 AND (
+				$2 = '' OR EXISTS (
+					SELECT 1 FROM destinations WHERE deleted_at = 0 AND id = NULLIF($2, '')::UUID
+				)
+			)
+	`, destinationId, catalogId)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// AddItineraryExpense stores an expense together with the members it is
+// split between in a single transaction.
+func (repo *ItinerariesRepo) AddItineraryExpense(req models.ItineraryExpense) (*models.ItineraryExpense, error) {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	row := tx.QueryRow(`
+		INSERT INTO itinerary_expenses (
+			itinerary_id,
+			destination_id,
+			category,
+			amount,
+			currency,
+			description,
+			spent_on,
+			created_by,
+			paid_by,
+			split_type
+		)
+		VALUES (
+			$1,
+			NULLIF($2, '')::UUID,
+			$3,
+			$4::BIGINT / 100.0,
+			$5,
+			$6,
+			NULLIF($7, '')::DATE,
+			$8,
+			COALESCE(NULLIF($9, '')::UUID, $8),
+			COALESCE(NULLIF($10, ''), 'none')
+		)
+		RETURNING
+			`+expenseColumns+`
+	`, req.ItineraryId, req.DestinationId, req.Category, req.AmountCents, req.Currency, req.Description, req.SpentOn, req.CreatedBy, req.PaidBy, req.SplitType)
+
+	expense, err := scanItineraryExpense(row)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range req.Participants {
+		_, err = tx.Exec(`
+			INSERT INTO itinerary_expense_participants (
+				expense_id,
+				user_id,
+				shares,
+				amount
+			)
+			VALUES (
+				$1,
+				$2,
+				NULLIF($3, 0),
+				NULLIF($4::BIGINT, 0) / 100.0
+			)
+		`, expense.ID, p.UserId, p.Shares, p.AmountCents)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	expense.Participants = req.Participants
+
+	return expense, nil
+}
+
+// GetItineraryExpense returns a single expense, used to authorize changes.
+func (repo *ItinerariesRepo) GetItineraryExpense(id string) (*models.ItineraryExpense, error) {
+	row := repo.DB.QueryRow(`
+		SELECT
+			`+expenseColumns+`
+		FROM
+			itinerary_expenses
+		WHERE
+			id = $1
+	`, id)
+
+	return scanItineraryExpense(row)
+}
+
+func (repo *ItinerariesRepo) DeleteItineraryExpense(id string) error {
+	res, err := repo.DB.Exec(`
+		DELETE FROM
+			itinerary_expenses
+		WHERE
+			id = $1
+	`, id)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListItineraryExpenses returns the expenses of an itinerary, optionally only
+// those of one category, oldest first.
+func (repo *ItinerariesRepo) ListItineraryExpenses(itineraryId, category string) ([]models.ItineraryExpense, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			`+expenseColumns+`
+		FROM
+			itinerary_expenses
+		WHERE
+			itinerary_id = $1 AND ($2 = '' OR category = $2)
+		ORDER BY
+			spent_on NULLS LAST, created_at
+	`, itineraryId, category)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var expenses []models.ItineraryExpense
+	for rows.Next() {
+		expense, err := scanItineraryExpense(rows)
+		if err != nil {
+			return nil, err
+		}
+		expenses = append(expenses, *expense)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	participants, err := repo.getExpenseParticipants(itineraryId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range expenses {
+		expenses[i].Participants = participants[expenses[i].ID]
+	}
+
+	return expenses, nil
+}
+
+// getExpenseParticipants returns the participants of every expense of an
+// itinerary keyed by expense id.
+func (repo *ItinerariesRepo) getExpenseParticipants(itineraryId string) (map[string][]models.ExpenseShare, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			p.expense_id,
+			p.user_id,
+			COALESCE(p.shares, 0),
+			COALESCE(ROUND(p.amount * 100)::BIGINT, 0)
+		FROM
+			itinerary_expense_participants p
+		JOIN
+			itinerary_expenses e ON e.id = p.expense_id
+		WHERE
+			e.itinerary_id = $1
+		ORDER BY
+			p.user_id
+	`, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	participants := map[string][]models.ExpenseShare{}
+	for rows.Next() {
+		var expenseId string
+		var share models.ExpenseShare
+
+		if err = rows.Scan(&expenseId, &share.UserId, &share.Shares, &share.AmountCents); err != nil {
+			return nil, err
+		}
+
+		participants[expenseId] = append(participants[expenseId], share)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+// GetItineraryCurrency returns the base currency of an itinerary.
+func (repo *ItinerariesRepo) GetItineraryCurrency(id string) (string, error) {
+	var code string
+	err := repo.DB.QueryRow(`
+		SELECT
+			currency
+		FROM
+			itineraries
+		WHERE
+			deleted_at = 0 AND id = $1
+	`, id).Scan(&code)
+
+	return code, err
+}
+
+// GetStayCosts returns the stays of an itinerary with the length of each stay
+// in days (both ends included) and the daily average of the linked catalog
+// destination.
+func (repo *ItinerariesRepo) GetStayCosts(itineraryId string) ([]models.StayCost, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			i_d.id,
+			i_d.name,
+			COALESCE(d.id::TEXT, ''),
+			i_d.end_date - i_d.start_date + 1,
+			COALESCE(ROUND(d.average_cost_per_day * 100)::BIGINT, -1),
+			COALESCE(d.currency, '')
+		FROM
+			itinerary_destinations i_d
+		JOIN
+			itineraries i ON i.id = i_d.itinerary_id
+		LEFT JOIN
+			destinations d ON d.id = i_d.destination_id AND d.deleted_at = 0
+		WHERE
+			i.deleted_at = 0 AND i_d.itinerary_id = $1
+		ORDER BY
+			i_d.start_date
+	`, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stays []models.StayCost
+	for rows.Next() {
+		var stay models.StayCost
+
+		err = rows.Scan(&stay.DestinationId, &stay.Name, &stay.CatalogDestinationId, &stay.Days, &stay.DailyCostCents, &stay.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		stays = append(stays, stay)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stays, nil
+}
+
+const expenseColumns = `id,
+			itinerary_id,
+			COALESCE(destination_id::TEXT, ''),
+			category,
+			ROUND(amount * 100)::BIGINT,
+			currency,
+			COALESCE(description, ''),
+			COALESCE(TO_CHAR(spent_on, 'YYYY-MM-DD'), ''),
+			created_by,
+			created_at,
+			paid_by,
+			split_type`
+
+func scanItineraryExpense(row rowScanner) (*models.ItineraryExpense, error) {
+	var e models.ItineraryExpense
+
+	err := row.Scan(&e.ID, &e.ItineraryId, &e.DestinationId, &e.Category, &e.AmountCents, &e.Currency, &e.Description, &e.SpentOn, &e.CreatedBy, &e.CreatedAt, &e.PaidBy, &e.SplitType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
@@ -0,0 +1,466 @@
+package postgres
+
+import (
+	pb "content-service/generated/itineraries"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+func (repo *ItinerariesRepo) CreateChecklist(itineraryId, userId, title string) (*pb.Checklist, error) {
+	var resp pb.Checklist
+
+	err := repo.DB.QueryRow(`
+		INSERT INTO itinerary_checklists (
+			itinerary_id,
+			title,
+			created_by
+		)
+		VALUES (
+			$1,
+			$2,
+			$3
+		)
+		RETURNING
+			`+checklistColumns+`
+	`, itineraryId, title, userId).Scan(&resp.Id, &resp.ItineraryId, &resp.Title, &resp.Template, &resp.CreatedBy, &resp.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListChecklistTemplates returns every template with its items in order.
+func (repo *ItinerariesRepo) ListChecklistTemplates() ([]*pb.ChecklistTemplate, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			t.name,
+			COALESCE(t.description, ''),
+			i.title,
+			COALESCE(i.days_before_start, -1)
+		FROM
+			checklist_templates t
+		JOIN
+			checklist_template_items i ON i.template_name = t.name
+		ORDER BY
+			t.name, i.position
+	`)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*pb.ChecklistTemplate
+	for rows.Next() {
+		var name, description string
+		var item pb.ChecklistTemplateItem
+
+		if err = rows.Scan(&name, &description, &item.Title, &item.DaysBeforeStart); err != nil {
+			return nil, err
+		}
+
+		if len(templates) == 0 || templates[len(templates)-1].Name != name {
+			templates = append(templates, &pb.ChecklistTemplate{Name: name, Description: description})
+		}
+		last := templates[len(templates)-1]
+		last.Items = append(last.Items, &item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// CreateChecklistFromTemplate creates a checklist named after template with
+// the given items, in order, in a single transaction.
+func (repo *ItinerariesRepo) CreateChecklistFromTemplate(itineraryId, userId, template string, items []*pb.ChecklistItem) (*pb.Checklist, error) {
+	var resp pb.Checklist
+
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(`
+		INSERT INTO itinerary_checklists (
+			itinerary_id,
+			title,
+			template_name,
+			created_by
+		)
+		VALUES (
+			$1,
+			INITCAP($2) || ' checklist',
+			$2,
+			$3
+		)
+		RETURNING
+			`+checklistColumns+`
+	`, itineraryId, template, userId).Scan(&resp.Id, &resp.ItineraryId, &resp.Title, &resp.Template, &resp.CreatedBy, &resp.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	titles := make([]string, len(items))
+	dueDates := make([]string, len(items))
+	for i, item := range items {
+		titles[i] = item.Title
+		dueDates[i] = item.DueDate
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO itinerary_checklist_items (
+			checklist_id,
+			title,
+			due_date,
+			position
+		)
+		SELECT
+			$1,
+			t.title,
+			NULLIF(t.due_date, '')::DATE,
+			t.position
+		FROM
+			UNNEST($2::TEXT[], $3::TEXT[]) WITH ORDINALITY AS t(title, due_date, position)
+	`, resp.Id, pq.Array(titles), pq.Array(dueDates))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	lists, err := repo.ListChecklists(itineraryId)
+	if err != nil {
+		return nil, err
+	}
+	for _, list := range lists {
+		if list.Id == resp.Id {
+			return list, nil
+		}
+	}
+
+	return &resp, nil
+}
+
+// ListChecklists returns the checklists of an itinerary with their items and
+// progress.
+func (repo *ItinerariesRepo) ListChecklists(itineraryId string) ([]*pb.Checklist, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			`+checklistColumns+`
+		FROM
+			itinerary_checklists
+		WHERE
+			itinerary_id = $1
+		ORDER BY
+			created_at
+	`, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var checklists []*pb.Checklist
+	byId := map[string]*pb.Checklist{}
+	for rows.Next() {
+		var c pb.Checklist
+
+		err = rows.Scan(&c.Id, &c.ItineraryId, &c.Title, &c.Template, &c.CreatedBy, &c.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		checklists = append(checklists, &c)
+		byId[c.Id] = &c
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	items, err := repo.DB.Query(`
+		SELECT
+			`+checklistItemColumns+`
+		FROM
+			itinerary_checklist_items
+		WHERE
+			checklist_id IN (SELECT id FROM itinerary_checklists WHERE itinerary_id = $1)
+		ORDER BY
+			position, created_at
+	`, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer items.Close()
+
+	for items.Next() {
+		item, err := scanChecklistItem(items)
+		if err != nil {
+			return nil, err
+		}
+
+		c, ok := byId[item.ChecklistId]
+		if !ok {
+			continue
+		}
+		c.Items = append(c.Items, item)
+		c.TotalCount++
+		if item.Done {
+			c.DoneCount++
+		}
+	}
+
+	if err = items.Err(); err != nil {
+		return nil, err
+	}
+
+	return checklists, nil
+}
+
+// GetChecklistItineraryId returns the itinerary a checklist belongs to.
+func (repo *ItinerariesRepo) GetChecklistItineraryId(checklistId string) (string, error) {
+	var itineraryId string
+	err := repo.DB.QueryRow(`
+		SELECT
+			itinerary_id
+		FROM
+			itinerary_checklists
+		WHERE
+			id = $1
+	`, checklistId).Scan(&itineraryId)
+
+	return itineraryId, err
+}
+
+func (repo *ItinerariesRepo) DeleteChecklist(id string) error {
+	res, err := repo.DB.Exec(`
+		DELETE FROM
+			itinerary_checklists
+		WHERE
+			id = $1
+	`, id)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+// AddChecklistItem appends an item to the end of a checklist.
+func (repo *ItinerariesRepo) AddChecklistItem(checklistId, title, assigneeId, dueDate string) (*pb.ChecklistItem, error) {
+	row := repo.DB.QueryRow(`
+		INSERT INTO itinerary_checklist_items (
+			checklist_id,
+			title,
+			assignee_id,
+			due_date,
+			position
+		)
+		VALUES (
+			$1,
+			$2,
+			NULLIF($3, '')::UUID,
+			NULLIF($4, '')::DATE,
+			(SELECT COALESCE(MAX(position), 0) + 1 FROM itinerary_checklist_items WHERE checklist_id = $1)
+		)
+		RETURNING
+			`+checklistItemColumns+`
+	`, checklistId, title, assigneeId, dueDate)
+
+	return scanChecklistItem(row)
+}
+
+// GetChecklistItem returns an item together with the itinerary it belongs to.
+func (repo *ItinerariesRepo) GetChecklistItem(id string) (*pb.ChecklistItem, string, error) {
+	var itineraryId string
+	item, err := scanChecklistItem(repo.DB.QueryRow(`
+		SELECT
+			`+checklistItemColumns+`
+		FROM
+			itinerary_checklist_items
+		WHERE
+			id = $1
+	`, id))
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	itineraryId, err = repo.GetChecklistItineraryId(item.ChecklistId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return item, itineraryId, nil
+}
+
+func (repo *ItinerariesRepo) UpdateChecklistItem(id, title, assigneeId, dueDate string) (*pb.ChecklistItem, error) {
+	row := repo.DB.QueryRow(`
+		UPDATE
+			itinerary_checklist_items
+		SET
+			title = $2,
+			assignee_id = NULLIF($3, '')::UUID,
+			due_date = NULLIF($4, '')::DATE
+		WHERE
+			id = $1
+		RETURNING
+			`+checklistItemColumns+`
+	`, id, title, assigneeId, dueDate)
+
+	return scanChecklistItem(row)
+}
+
+// SetChecklistItemDone ticks an item off, or reopens it when done is false.
+func (repo *ItinerariesRepo) SetChecklistItemDone(id, userId string, done bool) (*pb.ChecklistItem, error) {
+	row := repo.DB.QueryRow(`
+		UPDATE
+			itinerary_checklist_items
+		SET
+			done = $3,
+			done_by = CASE WHEN $3 THEN $2::UUID END,
+			done_at = CASE WHEN $3 THEN CURRENT_TIMESTAMP END
+		WHERE
+			id = $1
+		RETURNING
+			`+checklistItemColumns+`
+	`, id, userId, done)
+
+	return scanChecklistItem(row)
+}
+
+func (repo *ItinerariesRepo) DeleteChecklistItem(id string) error {
+	res, err := repo.DB.Exec(`
+		DELETE FROM
+			itinerary_checklist_items
+		WHERE
+			id = $1
+	`, id)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+// GetChecklistReminders returns the open items of trips the user takes part
+// in that start between today and withinDays days later. Only items assigned
+// to the user or to nobody are included, those due first come first.
+func (repo *ItinerariesRepo) GetChecklistReminders(userId, today string, withinDays int32) ([]*pb.ChecklistReminder, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			ci.id,
+			ci.checklist_id,
+			ci.title,
+			COALESCE(ci.assignee_id::TEXT, ''),
+			COALESCE(TO_CHAR(ci.due_date, 'YYYY-MM-DD'), ''),
+			ci.done,
+			COALESCE(ci.done_by::TEXT, ''),
+			COALESCE(ci.done_at::TEXT, ''),
+			ci.created_at,
+			i.id,
+			i.title,
+			c.title,
+			TO_CHAR(i.start_date, 'YYYY-MM-DD'),
+			i.start_date - $2::DATE,
+			COALESCE(ci.due_date < $2::DATE, FALSE)
+		FROM
+			itinerary_checklist_items ci
+		JOIN
+			itinerary_checklists c ON c.id = ci.checklist_id
+		JOIN
+			itineraries i ON i.id = c.itinerary_id
+		JOIN
+			itinerary_members m ON m.itinerary_id = i.id
+		WHERE
+			i.deleted_at = 0 AND
+			m.user_id = $1 AND m.status = 'accepted' AND
+			ci.done = FALSE AND
+			(ci.assignee_id IS NULL OR ci.assignee_id = $1) AND
+			i.start_date BETWEEN $2::DATE AND $2::DATE + $3::INT
+		ORDER BY
+			ci.due_date NULLS LAST, i.start_date, ci.position
+	`, userId, today, withinDays)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reminders []*pb.ChecklistReminder
+	for rows.Next() {
+		var item pb.ChecklistItem
+		var r pb.ChecklistReminder
+
+		err = rows.Scan(&item.Id, &item.ChecklistId, &item.Title, &item.AssigneeId, &item.DueDate, &item.Done, &item.DoneBy, &item.DoneAt, &item.CreatedAt,
+			&r.ItineraryId, &r.ItineraryTitle, &r.ChecklistTitle, &r.StartDate, &r.DaysUntilStart, &r.Overdue)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Item = &item
+		reminders = append(reminders, &r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reminders, nil
+}
+
+const checklistColumns = `id,
+			itinerary_id,
+			title,
+			COALESCE(template_name, ''),
+			created_by,
+			created_at`
+
+const checklistItemColumns = `id,
+			checklist_id,
+			title,
+			COALESCE(assignee_id::TEXT, ''),
+			COALESCE(TO_CHAR(due_date, 'YYYY-MM-DD'), ''),
+			done,
+			COALESCE(done_by::TEXT, ''),
+			COALESCE(done_at::TEXT, ''),
+			created_at`
+
+func scanChecklistItem(row rowScanner) (*pb.ChecklistItem, error) {
+	var item pb.ChecklistItem
+
+	err := row.Scan(&item.Id, &item.ChecklistId, &item.Title, &item.AssigneeId, &item.DueDate, &item.Done, &item.DoneBy, &item.DoneAt, &item.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// expectAffected turns an update or delete that touched no rows into
+// sql.ErrNoRows.
+func expectAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
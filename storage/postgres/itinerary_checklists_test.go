@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	pb "content-service/generated/itineraries"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItineraryChecklists(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	owner := "975799c4-bd72-43c8-b0c5-93bd9461e033"
+	today := time.Now().UTC()
+
+	itinerary, err := repo.CreateItinerary(&pb.CreateItineraryRequest{
+		Title:     "Beach week",
+		StartDate: today.AddDate(0, 0, 3).Format("2006-01-02"),
+		EndDate:   today.AddDate(0, 0, 10).Format("2006-01-02"),
+		AthorId:   owner,
+	})
+	assert.NoError(t, err)
+
+	templates, err := repo.ListChecklistTemplates()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, templates)
+
+	checklist, err := repo.CreateChecklistFromTemplate(itinerary.Id, owner, "beach", []*pb.ChecklistItem{
+		{Title: "Swimwear", DueDate: today.AddDate(0, 0, 2).Format("2006-01-02")},
+		{Title: "Pack light"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "beach", checklist.Template)
+	assert.Equal(t, "Beach checklist", checklist.Title)
+	assert.Equal(t, int32(2), checklist.TotalCount)
+
+	item, err := repo.AddChecklistItem(checklist.Id, "Snorkel", owner, today.AddDate(0, 0, 1).Format("2006-01-02"))
+	assert.NoError(t, err)
+	assert.Equal(t, owner, item.AssigneeId)
+
+	item, err = repo.SetChecklistItemDone(item.Id, owner, true)
+	assert.NoError(t, err)
+	assert.True(t, item.Done)
+	assert.Equal(t, owner, item.DoneBy)
+
+	reminders, err := repo.GetChecklistReminders(owner, today.Format("2006-01-02"), 7)
+	assert.NoError(t, err)
+	for _, r := range reminders {
+		assert.False(t, r.Item.Done)
+		if r.ItineraryId == itinerary.Id {
+			assert.Equal(t, int32(3), r.DaysUntilStart)
+		}
+	}
+
+	assert.NoError(t, repo.DeleteChecklistItem(item.Id))
+	assert.NoError(t, repo.DeleteChecklist(checklist.Id))
+}
@@ -0,0 +1,278 @@
+package postgres
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"database/sql"
+)
+
+func insertItineraryOwner(tx *sql.Tx, itineraryId, userId string) error {
+	_, err := tx.Exec(`
+		INSERT INTO itinerary_members (
+			itinerary_id,
+			user_id,
+			role,
+			status,
+			invited_by,
+			accepted_at
+		)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$2,
+			CURRENT_TIMESTAMP
+		)
+	`, itineraryId, userId, models.RoleOwner, models.MemberAccepted)
+
+	return err
+}
+
+// GetMemberRole returns the role of an accepted member of a live itinerary.
+// It returns sql.ErrNoRows when the user has no access.
+func (repo *ItinerariesRepo) GetMemberRole(itineraryId, userId string) (string, error) {
+	var role string
+	err := repo.DB.QueryRow(`
+		SELECT
+			m.role
+		FROM
+			itinerary_members m
+		JOIN
+			itineraries i ON i.id = m.itinerary_id
+		WHERE
+			i.deleted_at = 0 AND m.itinerary_id = $1 AND m.user_id = $2 AND m.status = $3
+	`, itineraryId, userId, models.MemberAccepted).Scan(&role)
+
+	return role, err
+}
+
+// InviteMember adds a pending membership. It returns sql.ErrNoRows when the
+// user is already a member or has already been invited.
+func (repo *ItinerariesRepo) InviteMember(itineraryId, inviterId, userId, role string) (*pb.ItineraryMember, error) {
+	row := repo.DB.QueryRow(`
+		INSERT INTO itinerary_members (
+			itinerary_id,
+			user_id,
+			role,
+			status,
+			invited_by
+		)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5
+		)
+		ON CONFLICT (itinerary_id, user_id) DO NOTHING
+		RETURNING
+			itinerary_id,
+			user_id,
+			role,
+			status,
+			COALESCE(invited_by::TEXT, ''),
+			created_at,
+			accepted_at
+	`, itineraryId, userId, role, models.MemberInvited, inviterId)
+
+	return scanItineraryMember(row)
+}
+
+// AcceptInvitation turns a pending invitation into a membership.
+func (repo *ItinerariesRepo) AcceptInvitation(itineraryId, userId string) (*pb.ItineraryMember, error) {
+	row := repo.DB.QueryRow(`
+		UPDATE
+			itinerary_members
+		SET
+			status = $3,
+			accepted_at = CURRENT_TIMESTAMP
+		WHERE
+			itinerary_id = $1 AND user_id = $2 AND status = $4
+		RETURNING
+			itinerary_id,
+			user_id,
+			role,
+			status,
+			COALESCE(invited_by::TEXT, ''),
+			created_at,
+			accepted_at
+	`, itineraryId, userId, models.MemberAccepted, models.MemberInvited)
+
+	return scanItineraryMember(row)
+}
+
+// RemoveMember deletes a membership or a pending invitation. The owner can
+// not be removed.
+func (repo *ItinerariesRepo) RemoveMember(itineraryId, userId string) (*pb.RemoveItineraryMemberResponse, error) {
+	res, err := repo.DB.Exec(`
+		DELETE FROM itinerary_members
+		WHERE itinerary_id = $1 AND user_id = $2 AND role <> $3
+	`, itineraryId, userId, models.RoleOwner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &pb.RemoveItineraryMemberResponse{
+		Message: "Member removed successfully",
+	}, nil
+}
+
+func (repo *ItinerariesRepo) ListMembers(itineraryId string) ([]*pb.ItineraryMember, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			itinerary_id,
+			user_id,
+			role,
+			status,
+			COALESCE(invited_by::TEXT, ''),
+			created_at,
+			accepted_at
+		FROM
+			itinerary_members
+		WHERE
+			itinerary_id = $1
+		ORDER BY
+			created_at
+	`, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*pb.ItineraryMember
+	for rows.Next() {
+		member, err := scanItineraryMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// ListMyItineraries lists the itineraries the user owns, was invited to or
+// has joined, most recent trips first.
+func (repo *ItinerariesRepo) ListMyItineraries(req *pb.ListMyItinerariesRequest) (*pb.ListMyItinerariesResponse, error) {
+	var resp []*pb.MyItinerary
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := repo.DB.Query(`
+		SELECT
+			i.id,
+			i.title,
+			i.author_id,
+			i.start_date,
+			i.end_date,
+			i.created_at,
+			m.role,
+			m.status
+		FROM
+			itinerary_members m
+		JOIN
+			itineraries i ON i.id = m.itinerary_id
+		WHERE
+			i.deleted_at = 0 AND m.user_id = $1
+		ORDER BY
+			i.start_date DESC
+		OFFSET $2
+		LIMIT $3
+	`, req.UserId, offset, req.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var itinerary pb.Itinerary
+		var author pb.Authors
+		var mine pb.MyItinerary
+
+		err = rows.Scan(&itinerary.Id, &itinerary.Title, &author.Id, &itinerary.StartDate, &itinerary.EndDate, &itinerary.CreatedAt, &mine.Role, &mine.Status)
+		if err != nil {
+			return nil, err
+		}
+		itinerary.Author = &author
+		mine.Itinerary = &itinerary
+
+		resp = append(resp, &mine)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var total int32
+	err = repo.DB.QueryRow(`
+		SELECT
+			COUNT(*)
+		FROM
+			itinerary_members m
+		JOIN
+			itineraries i ON i.id = m.itinerary_id
+		WHERE
+			i.deleted_at = 0 AND m.user_id = $1
+	`, req.UserId).Scan(&total)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMyItinerariesResponse{
+		Itineraries: resp,
+		Total:       total,
+		Limit:       req.Limit,
+		Page:        req.Page,
+	}, nil
+}
+
+// GetActivityItineraryId returns the itinerary an activity belongs to.
+func (repo *ItinerariesRepo) GetActivityItineraryId(activityId string) (string, error) {
+	var itineraryId string
+	err := repo.DB.QueryRow(`
+		SELECT
+			i_d.itinerary_id
+		FROM
+			itinerary_activities a
+		JOIN
+			itinerary_destinations i_d ON i_d.id = a.destination_id
+		WHERE
+			a.id = $1
+	`, activityId).Scan(&itineraryId)
+
+	return itineraryId, err
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanItineraryMember(row rowScanner) (*pb.ItineraryMember, error) {
+	var member pb.ItineraryMember
+	var acceptedAt sql.NullString
+
+	err := row.Scan(&member.ItineraryId, &member.UserId, &member.Role, &member.Status, &member.InvitedBy, &member.CreatedAt, &acceptedAt)
+	if err != nil {
+		return nil, err
+	}
+	member.AcceptedAt = acceptedAt.String
+
+	return &member, nil
+}
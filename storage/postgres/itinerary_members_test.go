@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	pb "content-service/generated/itineraries"
+	"content-service/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItineraryMembership(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	owner := "975799c4-bd72-43c8-b0c5-93bd9461e033"
+	friend := "9b0cf2c8-308c-4896-a737-511bff1bb991"
+
+	itinerary, err := repo.CreateItinerary(&pb.CreateItineraryRequest{
+		Title:     "Group trip",
+		StartDate: time.Now().Format("2006-01-02"),
+		EndDate:   time.Now().Add(48 * time.Hour).Format("2006-01-02"),
+		AthorId:   owner,
+	})
+	assert.NoError(t, err)
+
+	role, err := repo.GetMemberRole(itinerary.Id, owner)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RoleOwner, role)
+
+	member, err := repo.InviteMember(itinerary.Id, owner, friend, models.RoleEditor)
+	assert.NoError(t, err)
+	assert.Equal(t, models.MemberInvited, member.Status)
+
+	_, err = repo.GetMemberRole(itinerary.Id, friend)
+	assert.Error(t, err)
+
+	member, err = repo.AcceptInvitation(itinerary.Id, friend)
+	assert.NoError(t, err)
+	assert.Equal(t, models.MemberAccepted, member.Status)
+
+	mine, err := repo.ListMyItineraries(&pb.ListMyItinerariesRequest{UserId: friend, Page: 1, Limit: 10})
+	assert.NoError(t, err)
+	assert.NotZero(t, mine.Total)
+
+	_, err = repo.RemoveMember(itinerary.Id, owner)
+	assert.Error(t, err)
+
+	_, err = repo.RemoveMember(itinerary.Id, friend)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	pb "content-service/generated/itineraries"
+	"database/sql"
+	"time"
+)
+
+const shareLinkColumns = `
+			id,
+			itinerary_id,
+			created_by,
+			expires_at,
+			revoked_at,
+			views,
+			last_viewed_at,
+			created_at`
+
+// CreateShareLink stores a new public link to an itinerary. The token is
+// signed by the service, only the link itself is kept here.
+func (repo *ItinerariesRepo) CreateShareLink(itineraryId, userId string, expiresAt time.Time) (*pb.ShareLink, error) {
+	return scanShareLink(repo.DB.QueryRow(`
+		INSERT INTO itinerary_share_links (
+			itinerary_id,
+			created_by,
+			expires_at
+		)
+		VALUES (
+			$1,
+			$2,
+			$3
+		)
+		RETURNING`+shareLinkColumns,
+		itineraryId, userId, expiresAt))
+}
+
+func (repo *ItinerariesRepo) GetShareLink(id string) (*pb.ShareLink, error) {
+	return scanShareLink(repo.DB.QueryRow(`
+		SELECT`+shareLinkColumns+`
+		FROM
+			itinerary_share_links
+		WHERE
+			id = $1
+	`, id))
+}
+
+// ListShareLinks returns every link of an itinerary, revoked and expired ones
+// included, newest first.
+func (repo *ItinerariesRepo) ListShareLinks(itineraryId string) ([]*pb.ShareLink, error) {
+	rows, err := repo.DB.Query(`
+		SELECT`+shareLinkColumns+`
+		FROM
+			itinerary_share_links
+		WHERE
+			itinerary_id = $1
+		ORDER BY
+			created_at DESC
+	`, itineraryId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*pb.ShareLink
+	for rows.Next() {
+		link, err := scanShareLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (repo *ItinerariesRepo) RevokeShareLink(id string) error {
+	res, err := repo.DB.Exec(`
+		UPDATE
+			itinerary_share_links
+		SET
+			revoked_at = CURRENT_TIMESTAMP
+		WHERE
+			id = $1 AND revoked_at IS NULL
+	`, id)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
+
+// RecordShareLinkView counts a visit through a link and returns the
+// itinerary it points to. Revoked and expired links, as well as links to
+// deleted itineraries, give sql.ErrNoRows.
+func (repo *ItinerariesRepo) RecordShareLinkView(id string) (string, error) {
+	var itineraryId string
+
+	err := repo.DB.QueryRow(`
+		UPDATE
+			itinerary_share_links l
+		SET
+			views = l.views + 1,
+			last_viewed_at = CURRENT_TIMESTAMP
+		FROM
+			itineraries i
+		WHERE
+			i.id = l.itinerary_id AND i.deleted_at = 0 AND
+			l.id = $1 AND l.revoked_at IS NULL AND l.expires_at > CURRENT_TIMESTAMP
+		RETURNING
+			l.itinerary_id
+	`, id).Scan(&itineraryId)
+
+	if err != nil {
+		return "", err
+	}
+
+	return itineraryId, nil
+}
+
+func scanShareLink(row rowScanner) (*pb.ShareLink, error) {
+	var link pb.ShareLink
+	var expiresAt, createdAt time.Time
+	var revokedAt, lastViewedAt sql.NullTime
+
+	err := row.Scan(&link.Id, &link.ItineraryId, &link.CreatedBy, &expiresAt, &revokedAt, &link.Views, &lastViewedAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	link.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	link.CreatedAt = createdAt.UTC().Format(time.RFC3339)
+	if revokedAt.Valid {
+		link.RevokedAt = revokedAt.Time.UTC().Format(time.RFC3339)
+	}
+	if lastViewedAt.Valid {
+		link.LastViewedAt = lastViewedAt.Time.UTC().Format(time.RFC3339)
+	}
+
+	return &link, nil
+}
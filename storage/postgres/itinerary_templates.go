@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	pb "content-service/generated/itineraries"
+)
+
+// CreateItineraryTemplate stores a template with its stops and activities in
+// a single transaction.
+func (repo *ItinerariesRepo) CreateItineraryTemplate(req *pb.CreateItineraryTemplateRequest, days int32) (*pb.ItineraryTemplate, error) {
+	tx, err := repo.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var id string
+	err = tx.QueryRow(`
+		INSERT INTO itinerary_templates (
+			title,
+			description,
+			days,
+			author_id
+		)
+		VALUES (
+			$1,
+			$2,
+			$3,
+			$4
+		)
+		RETURNING
+			id
+	`, req.Title, req.Description, days, req.UserId).Scan(&id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stop := range req.Stops {
+		var stopId string
+		err = tx.QueryRow(`
+			INSERT INTO itinerary_template_stops (
+				template_id,
+				name,
+				start_day,
+				end_day,
+				destination_id
+			)
+			VALUES (
+				$1,
+				$2,
+				$3,
+				$4,
+				NULLIF($5, '')::UUID
+			)
+			RETURNING
+				id
+		`, id, stop.Name, stop.StartDay, stop.EndDay, stop.CatalogDestinationId).Scan(&stopId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, a := range stop.Activities {
+			_, err = tx.Exec(`
+				INSERT INTO itinerary_template_activities (
+					stop_id,
+					title,
+					day,
+					start_time,
+					end_time,
+					time_zone,
+					location,
+					notes,
+					position
+				)
+				VALUES (
+					$1,
+					$2,
+					NULLIF($3, 0),
+					NULLIF($4, '')::TIME,
+					NULLIF($5, '')::TIME,
+					COALESCE(NULLIF($6, ''), 'UTC'),
+					NULLIF($7, ''),
+					NULLIF($8, ''),
+					$9
+				)
+			`, stopId, a.Title, a.Day, a.StartTime, a.EndTime, a.TimeZone, a.Location, a.Notes, i)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return repo.GetItineraryTemplate(id)
+}
+
+// GetItineraryTemplate returns a template with its stops in trip order.
+func (repo *ItinerariesRepo) GetItineraryTemplate(id string) (*pb.ItineraryTemplate, error) {
+	var resp pb.ItineraryTemplate
+
+	err := repo.DB.QueryRow(`
+		SELECT
+			t.id,
+			t.title,
+			COALESCE(t.description, ''),
+			t.days,
+			t.author_id,
+			t.created_at,
+			(
+				SELECT COUNT(*) FROM itineraries i
+				WHERE i.template_id = t.id AND i.deleted_at = 0
+			)
+		FROM
+			itinerary_templates t
+		WHERE
+			t.deleted_at = 0 AND t.id = $1
+	`, id).Scan(&resp.Id, &resp.Title, &resp.Description, &resp.Days, &resp.AuthorId, &resp.CreatedAt, &resp.UsesCount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := repo.DB.Query(`
+		SELECT
+			s.id,
+			s.name,
+			s.start_day,
+			s.end_day,
+			COALESCE(s.destination_id::TEXT, ''),
+			COALESCE(a.title, ''),
+			COALESCE(a.day, 0),
+			COALESCE(TO_CHAR(a.start_time, 'HH24:MI'), ''),
+			COALESCE(TO_CHAR(a.end_time, 'HH24:MI'), ''),
+			COALESCE(a.time_zone, ''),
+			COALESCE(a.location, ''),
+			COALESCE(a.notes, ''),
+			a.id IS NOT NULL
+		FROM
+			itinerary_template_stops s
+		LEFT JOIN
+			itinerary_template_activities a ON a.stop_id = s.id
+		WHERE
+			s.template_id = $1
+		ORDER BY
+			s.start_day, s.end_day, s.id, a.position
+	`, id)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lastStop string
+	for rows.Next() {
+		var stopId string
+		var stop pb.TemplateStop
+		var act pb.TemplateActivity
+		var hasActivity bool
+
+		err = rows.Scan(&stopId, &stop.Name, &stop.StartDay, &stop.EndDay, &stop.CatalogDestinationId,
+			&act.Title, &act.Day, &act.StartTime, &act.EndTime, &act.TimeZone, &act.Location, &act.Notes, &hasActivity)
+		if err != nil {
+			return nil, err
+		}
+
+		if stopId != lastStop {
+			resp.Stops = append(resp.Stops, &stop)
+			lastStop = stopId
+		}
+		if hasActivity {
+			current := resp.Stops[len(resp.Stops)-1]
+			current.Activities = append(current.Activities, &act)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListItineraryTemplates returns published templates, most used first.
+func (repo *ItinerariesRepo) ListItineraryTemplates(req *pb.ListItineraryTemplatesRequest) (*pb.ListItineraryTemplatesResponse, error) {
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := repo.DB.Query(`
+		SELECT
+			t.id
+		FROM
+			itinerary_templates t
+		WHERE
+			t.deleted_at = 0
+		ORDER BY
+			(SELECT COUNT(*) FROM itineraries i WHERE i.template_id = t.id AND i.deleted_at = 0) DESC,
+			t.created_at DESC
+		OFFSET $1 LIMIT $2
+	`, offset, req.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var templates []*pb.ItineraryTemplate
+	for _, id := range ids {
+		template, err := repo.GetItineraryTemplate(id)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	var total int32
+	err = repo.DB.QueryRow(`
+		SELECT
+			COUNT(*)
+		FROM
+			itinerary_templates
+		WHERE
+			deleted_at = 0
+	`).Scan(&total)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListItineraryTemplatesResponse{
+		Templates: templates,
+		Total:     total,
+		Page:      req.Page,
+		Limit:     req.Limit,
+	}, nil
+}
+
+// DeleteItineraryTemplate unpublishes a template. Itineraries already planned
+// from it are not touched.
+func (repo *ItinerariesRepo) DeleteItineraryTemplate(id string) error {
+	res, err := repo.DB.Exec(`
+		UPDATE
+			itinerary_templates
+		SET
+			deleted_at = DATE_PART('epoch', CURRENT_TIMESTAMP)::INT
+		WHERE
+			deleted_at = 0 AND id = $1
+	`, id)
+
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res)
+}
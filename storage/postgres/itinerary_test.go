@@ -3,6 +3,7 @@ package postgres
 import (
 	pb "content-service/generated/itineraries"
 	"content-service/models"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
@@ -241,7 +242,7 @@ func TestTotalCountriesVisited(t *testing.T) {
 
 	repo := NewItinerariesRepo(db)
 
-	total, err := repo.TotalCountriesVisited("9b0cf2c8-308c-4896-a737-511bff1bb991")
+	total, err := repo.TotalCountriesVisited("9b0cf2c8-308c-4896-a737-511bff1bb991", time.Now().Format("2006-01-02"))
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, total, int32(0))
 }
@@ -259,3 +260,418 @@ func TestMostPopularItinerary(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 }
+
+func TestAddItineraryActivity(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	req := models.ItineraryActivity{
+		DestinationId: "03b1ba1e-5048-4f88-9f42-282e3333ba81",
+		Activity:      "Sunset cruise",
+		Day:           time.Now().Format("2006-01-02"),
+		StartTime:     "18:30",
+		EndTime:       "20:00",
+		TimeZone:      "Europe/Lisbon",
+		Location:      "Cais do Sodré",
+	}
+
+	resp, err := repo.AddItineraryActivity(req)
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, req.Activity, resp.Activity)
+	assert.Equal(t, req.StartTime, resp.StartTime)
+	assert.Equal(t, req.TimeZone, resp.TimeZone)
+}
+
+func TestGetDestinationSchedule(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	resp, err := repo.GetDestinationSchedule("03b1ba1e-5048-4f88-9f42-282e3333ba81")
+	assert.NoError(t, err)
+	for _, act := range resp {
+		assert.NotEmpty(t, act.TimeZone)
+	}
+}
+
+func TestForkItinerary(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	resp, err := repo.ForkItinerary("26d176f2-4536-443f-bf53-5cf25d4ffc65", "975799c4-bd72-43c8-b0c5-93bd9461e033", "2030-06-01", "")
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "975799c4-bd72-43c8-b0c5-93bd9461e033", resp.AuthorId)
+
+	fork, err := repo.GetItinerary(resp.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "26d176f2-4536-443f-bf53-5cf25d4ffc65", fork.ForkedFrom)
+}
+
+func TestLikeItinerary(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	itineraryId := "26d176f2-4536-443f-bf53-5cf25d4ffc65"
+	userId := "9b0cf2c8-308c-4896-a737-511bff1bb991"
+
+	liked, err := repo.LikeItinerary(itineraryId, userId)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, liked.LikedAt)
+
+	again, err := repo.LikeItinerary(itineraryId, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, liked.LikesCount, again.LikesCount)
+
+	total, err := repo.CountItineraryLikes(itineraryId)
+	assert.NoError(t, err)
+	assert.Equal(t, liked.LikesCount, total)
+
+	unliked, err := repo.UnlikeItinerary(itineraryId, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, liked.LikesCount-1, unliked.LikesCount)
+}
+
+func TestItineraryComments(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	comment, err := repo.CreateItineraryComments(&pb.LeaveCommentRequest{
+		AuthorId:    "9b0cf2c8-308c-4896-a737-511bff1bb991",
+		ItineraryId: "26d176f2-4536-443f-bf53-5cf25d4ffc65",
+		Content:     "Great plan",
+	})
+	assert.NoError(t, err)
+
+	updated, err := repo.UpdateItineraryComment(&pb.UpdateItineraryCommentRequest{
+		Id:       comment.Id,
+		AuthorId: comment.AuthorId,
+		Content:  "Great plan, thanks",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Great plan, thanks", updated.Content)
+
+	_, err = repo.UpdateItineraryComment(&pb.UpdateItineraryCommentRequest{
+		Id:       comment.Id,
+		AuthorId: "975799c4-bd72-43c8-b0c5-93bd9461e033",
+		Content:  "hijacked",
+	})
+	assert.Error(t, err)
+
+	list, err := repo.GetItineraryComments(&pb.ListItineraryCommentsRequest{ItineraryId: comment.ItineraryId, Page: 1, Limit: 100})
+	assert.NoError(t, err)
+	assert.NotZero(t, list.Total)
+
+	_, err = repo.DeleteItineraryComment(comment.Id)
+	assert.NoError(t, err)
+}
+
+func TestItineraryExpenses(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	itineraryId := "26d176f2-4536-443f-bf53-5cf25d4ffc65"
+
+	expense, err := repo.AddItineraryExpense(models.ItineraryExpense{
+		ItineraryId: itineraryId,
+		Category:    models.ExpenseFood,
+		AmountCents: 1999,
+		Currency:    "EUR",
+		Description: "Pastéis de nata",
+		SpentOn:     "2024-05-01",
+		CreatedBy:   "9b0cf2c8-308c-4896-a737-511bff1bb991",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1999), expense.AmountCents)
+
+	expenses, err := repo.ListItineraryExpenses(itineraryId, models.ExpenseFood)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, expenses)
+
+	_, err = repo.GetStayCosts(itineraryId)
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.DeleteItineraryExpense(expense.ID))
+}
+
+func TestSharedItineraryExpense(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	itineraryId := "26d176f2-4536-443f-bf53-5cf25d4ffc65"
+	payer := "9b0cf2c8-308c-4896-a737-511bff1bb991"
+
+	expense, err := repo.AddItineraryExpense(models.ItineraryExpense{
+		ItineraryId: itineraryId,
+		Category:    models.ExpenseAccommodation,
+		AmountCents: 30000,
+		Currency:    "EUR",
+		CreatedBy:   payer,
+		SplitType:   models.SplitShares,
+		Participants: []models.ExpenseShare{
+			{UserId: payer, Shares: 2},
+			{UserId: "975799c4-bd72-43c8-b0c5-93bd9461e033", Shares: 1},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, payer, expense.PaidBy)
+
+	expenses, err := repo.ListItineraryExpenses(itineraryId, models.ExpenseAccommodation)
+	assert.NoError(t, err)
+	for _, e := range expenses {
+		if e.ID == expense.ID {
+			assert.Len(t, e.Participants, 2)
+		}
+	}
+
+	code, err := repo.GetItineraryCurrency(itineraryId)
+	assert.NoError(t, err)
+	assert.Len(t, code, 3)
+
+	assert.NoError(t, repo.DeleteItineraryExpense(expense.ID))
+}
+
+func TestInstantiateItineraryTemplate(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	curator := "975799c4-bd72-43c8-b0c5-93bd9461e033"
+
+	template, err := repo.CreateItineraryTemplate(&pb.CreateItineraryTemplateRequest{
+		UserId: curator,
+		Title:  "Kyoto long weekend",
+		Stops: []*pb.TemplateStop{
+			{Name: "Kyoto", StartDay: 1, EndDay: 3, Activities: []*pb.TemplateActivity{
+				{Title: "Fushimi Inari", Day: 1, StartTime: "07:00", TimeZone: "Asia/Tokyo"},
+			}},
+		},
+	}, 3)
+	assert.NoError(t, err)
+	assert.Len(t, template.Stops, 1)
+	assert.Len(t, template.Stops[0].Activities, 1)
+	assert.Equal(t, "07:00", template.Stops[0].Activities[0].StartTime)
+
+	itinerary, err := repo.InstantiateTemplate(template.Id, &pb.CreateItineraryRequest{
+		Title:     template.Title,
+		StartDate: "2024-04-05",
+		EndDate:   "2024-04-07",
+		AthorId:   "9b0cf2c8-308c-4896-a737-511bff1bb991",
+		Distinations: []*pb.Destination{
+			{Name: "Kyoto", StartDate: "2024-04-05", EndDate: "2024-04-07"},
+		},
+	})
+	assert.NoError(t, err)
+
+	template, err = repo.GetItineraryTemplate(template.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), template.UsesCount)
+
+	_, err = repo.DeleteItinerary(itinerary.Id)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.DeleteItineraryTemplate(template.Id))
+}
+
+func TestItineraryCoordinates(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	itinerary, err := repo.CreateItinerary(&pb.CreateItineraryRequest{
+		Title:     "Lisbon, spring",
+		StartDate: "2024-03-29",
+		EndDate:   "2024-04-01",
+		AthorId:   "9b0cf2c8-308c-4896-a737-511bff1bb991",
+		Distinations: []*pb.Destination{
+			{
+				Name:        "Lisbon",
+				StartDate:   "2024-03-29",
+				EndDate:     "2024-04-01",
+				Coordinates: &pb.GeoPoint{Latitude: 38.7223, Longitude: -9.1393},
+				Schedule: []*pb.Activity{
+					{Title: "Tram 28", Day: "2024-03-30", Coordinates: &pb.GeoPoint{Latitude: 38.7177, Longitude: -9.136}},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	destinations, err := repo.GetItineraryDestinations(itinerary.Id)
+	assert.NoError(t, err)
+	assert.Len(t, destinations, 1)
+	assert.Equal(t, &models.GeoPoint{Latitude: 38.7223, Longitude: -9.1393}, destinations[0].Coordinates)
+
+	schedule, err := repo.GetDestinationSchedule(destinations[0].ID)
+	assert.NoError(t, err)
+	assert.Len(t, schedule, 1)
+	assert.Equal(t, -9.136, schedule[0].Coordinates.Longitude)
+
+	assert.NoError(t, repo.SetDestinationCoordinates(destinations[0].ID, nil))
+	destination, err := repo.GetItineraryDestination(destinations[0].ID)
+	assert.NoError(t, err)
+	assert.Nil(t, destination.Coordinates)
+
+	_, err = repo.DeleteItinerary(itinerary.Id)
+	assert.NoError(t, err)
+}
+
+func TestItineraryShareLinks(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	itineraryId := "26d176f2-4536-443f-bf53-5cf25d4ffc65"
+	link, err := repo.CreateShareLink(itineraryId, "9b0cf2c8-308c-4896-a737-511bff1bb991", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), link.Views)
+
+	viewed, err := repo.RecordShareLinkView(link.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, itineraryId, viewed)
+
+	link, err = repo.GetShareLink(link.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), link.Views)
+	assert.NotEmpty(t, link.LastViewedAt)
+
+	assert.NoError(t, repo.RevokeShareLink(link.Id))
+	_, err = repo.RecordShareLinkView(link.Id)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	links, err := repo.ListShareLinks(itineraryId)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, links)
+}
+
+func TestSearchItineraries(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	author := "9b0cf2c8-308c-4896-a737-511bff1bb991"
+	itinerary, err := repo.CreateItinerary(&pb.CreateItineraryRequest{
+		Title:     "Portugal in eight days",
+		StartDate: "2024-03-28",
+		EndDate:   "2024-04-04",
+		AthorId:   author,
+		Distinations: []*pb.Destination{
+			{Name: "Lisbon", StartDate: "2024-03-28", EndDate: "2024-03-31"},
+			{Name: "Porto", StartDate: "2024-04-01", EndDate: "2024-04-04"},
+		},
+	})
+	assert.NoError(t, err)
+
+	found := func(req *pb.ListItinerariesRequest) bool {
+		req.Page, req.Limit = 1, 100
+		resp, err := repo.ListItineraries(req)
+		assert.NoError(t, err)
+		for _, it := range resp.Itineraries {
+			if it.Id == itinerary.Id {
+				return true
+			}
+		}
+		return false
+	}
+
+	assert.True(t, found(&pb.ListItinerariesRequest{Destination: "lisb", MinDays: 7, MaxDays: 10, AuthorId: author}))
+	assert.True(t, found(&pb.ListItinerariesRequest{Destination: "Lisbon", Months: []int32{3}, SortBy: models.SortPopular}))
+	assert.True(t, found(&pb.ListItinerariesRequest{Months: []int32{4}}))
+	// Lisbon is only visited in March.
+	assert.False(t, found(&pb.ListItinerariesRequest{Destination: "Lisbon", Months: []int32{4}}))
+	assert.False(t, found(&pb.ListItinerariesRequest{MaxDays: 7}))
+	assert.False(t, found(&pb.ListItinerariesRequest{Destination: "%"}))
+
+	_, err = repo.DeleteItinerary(itinerary.Id)
+	assert.NoError(t, err)
+}
+
+func TestListVisitedCountries(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewItinerariesRepo(db)
+
+	author := "9b0cf2c8-308c-4896-a737-511bff1bb991"
+	itinerary, err := repo.CreateItinerary(&pb.CreateItineraryRequest{
+		Title:     "Iberia",
+		StartDate: "2023-05-01",
+		EndDate:   "2023-05-10",
+		AthorId:   author,
+		Distinations: []*pb.Destination{
+			{Name: "Lisbon", StartDate: "2023-05-01", EndDate: "2023-05-04", CountryCode: "PT"},
+			{Name: "Porto", StartDate: "2023-05-05", EndDate: "2023-05-07", CountryCode: "PT"},
+			{Name: "Seville", StartDate: "2023-05-08", EndDate: "2023-05-10", CountryCode: "ES"},
+		},
+	})
+	assert.NoError(t, err)
+
+	total, err := repo.TotalCountriesVisited(author, "2023-05-11")
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, total, int32(2))
+
+	countries, err := repo.ListVisitedCountries(author, "2023-05-11")
+	assert.NoError(t, err)
+	codes := map[string]bool{}
+	for _, c := range countries {
+		codes[c.CountryCode] = true
+	}
+	assert.True(t, codes["PT"])
+	assert.True(t, codes["ES"])
+
+	_, err = repo.DeleteItinerary(itinerary.Id)
+	assert.NoError(t, err)
+}
@@ -25,13 +25,19 @@ func (repo *TravelStoriesRepo) CreateTravelStory(req *pb.CreateTravelStoryReques
 			title,
 			content,
 			location,
-			author_id
+			author_id,
+			destination_id,
+			country_code,
+			visited_on
 		)
 		VALUES (
 			$1,
 			$2,
 			$3,
-			$4
+			$4,
+			NULLIF($5, '')::UUID,
+			NULLIF($6, ''),
+			COALESCE(NULLIF($7, '')::DATE, CURRENT_DATE)
 		)
 		RETURNING
 			id,
@@ -39,10 +45,14 @@ func (repo *TravelStoriesRepo) CreateTravelStory(req *pb.CreateTravelStoryReques
 			content,
 			location,
 			author_id,
-			created_at
-	`, req.Title, req.Content, req.Location, req.AuthorId).
+			created_at,
+			COALESCE(destination_id::TEXT, ''),
+			COALESCE(country_code, ''),
+			TO_CHAR(visited_on, 'YYYY-MM-DD')
+	`, req.Title, req.Content, req.Location, req.AuthorId, req.DestinationId, req.CountryCode, req.VisitedOn).
 		Scan(&resp.Id, &resp.Title, &resp.Content,
-			&resp.Location, &resp.AuthorId, &resp.CreatedAt)
+			&resp.Location, &resp.AuthorId, &resp.CreatedAt,
+			&resp.DestinationId, &resp.CountryCode, &resp.VisitedOn)
 
 	if err != nil {
 		return nil, err
@@ -175,18 +185,24 @@ func (repo *TravelStoriesRepo) GetTravelStory(id string) (*pb.GetTravelStoryResp
 
 	err := repo.DB.QueryRow(`
 		SELECT
-			id,
-			title,
-			content,
-			location,
-			author_id,
-			created_at,
-			updated_at
+			s.id,
+			s.title,
+			s.content,
+			s.location,
+			s.author_id,
+			s.created_at,
+			s.updated_at,
+			COALESCE(s.destination_id::TEXT, ''),
+			COALESCE(s.country_code, d.country_code, ''),
+			COALESCE(TO_CHAR(s.visited_on, 'YYYY-MM-DD'), '')
 		FROM
-			stories
+			stories s
+		LEFT JOIN
+			destinations d ON d.id = s.destination_id
 		WHERE
-			deleted_at = 0 AND id = $1
-	`, id).Scan(&resp.Id, &resp.Title, &resp.Content, &resp.Location, &author.Id, &resp.CreatedAt, &resp.UpdatedAt)
+			s.deleted_at = 0 AND s.id = $1
+	`, id).Scan(&resp.Id, &resp.Title, &resp.Content, &resp.Location, &author.Id, &resp.CreatedAt, &resp.UpdatedAt,
+		&resp.DestinationId, &resp.CountryCode, &resp.VisitedOn)
 
 	if err != nil {
 		return nil, err
@@ -412,3 +428,50 @@ func (repo *TravelStoriesRepo) MostPopularStory(id string) (*communication.MostP
 
 	return &resp, err
 }
+
+// ListGeotaggedStories returns the stories of an author that name a country
+// or a catalog destination, oldest visit first.
+func (repo *TravelStoriesRepo) ListGeotaggedStories(authorId string) ([]models.GeotaggedStory, error) {
+	rows, err := repo.DB.Query(`
+		SELECT
+			s.id,
+			COALESCE(c.code, ''),
+			COALESCE(c.name, ''),
+			COALESCE(d.id::TEXT, ''),
+			COALESCE(d.name, ''),
+			TO_CHAR(COALESCE(s.visited_on, s.created_at::DATE), 'YYYY-MM-DD')
+		FROM
+			stories s
+		LEFT JOIN
+			destinations d ON d.id = s.destination_id
+		LEFT JOIN
+			countries c ON c.code = COALESCE(s.country_code, d.country_code)
+		WHERE
+			s.author_id = $1 AND s.deleted_at = 0 AND (c.code IS NOT NULL OR d.id IS NOT NULL)
+		ORDER BY
+			COALESCE(s.visited_on, s.created_at::DATE)
+	`, authorId)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stories []models.GeotaggedStory
+	for rows.Next() {
+		var story models.GeotaggedStory
+
+		err = rows.Scan(&story.ID, &story.CountryCode, &story.CountryName, &story.DestinationId, &story.DestinationName, &story.VisitedOn)
+		if err != nil {
+			return nil, err
+		}
+
+		stories = append(stories, story)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stories, nil
+}
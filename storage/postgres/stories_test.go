@@ -292,3 +292,41 @@ func TestMostPopularStory(t *testing.T) {
 	assert.NotEmpty(t, resp.Title)
 	assert.NotZero(t, resp.LikesCount)
 }
+
+func TestListGeotaggedStories(t *testing.T) {
+	db, err := ConnectDB()
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewTravelStoriesRepo(db)
+
+	author := "6f645314-23f1-482e-bf83-417439ee582b"
+	story, err := repo.CreateTravelStory(&pb.CreateTravelStoryRequest{
+		Title:       "Sunset at Miradouro",
+		Content:     "Content",
+		Location:    "Lisbon",
+		AuthorId:    author,
+		CountryCode: "PT",
+		VisitedOn:   "2023-05-02",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-05-02", story.VisitedOn)
+
+	stories, err := repo.ListGeotaggedStories(author)
+	assert.NoError(t, err)
+
+	var found bool
+	for _, s := range stories {
+		if s.ID == story.Id {
+			found = true
+			assert.Equal(t, "PT", s.CountryCode)
+			assert.Equal(t, "2023-05-02", s.VisitedOn)
+		}
+	}
+	assert.True(t, found)
+
+	_, err = repo.DeleteTravelStory(story.Id)
+	assert.NoError(t, err)
+}